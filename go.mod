@@ -1,5 +1,5 @@
 module github.com/andrerrcosta2/gtools
 
-go 1.22.0
+go 1.23.0
 
-require github.com/google/uuid v1.6.0 // indirect
+require github.com/google/uuid v1.6.0
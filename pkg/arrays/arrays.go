@@ -5,6 +5,7 @@ package arrays
 import (
 	"github.com/andrerrcosta2/gtools/pkg/constraints"
 	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"github.com/andrerrcosta2/gtools/pkg/gtools"
 )
 
 // Reverse reverses the order of elements in a slice.
@@ -391,6 +392,36 @@ func Fold[T, R any](arr *[]T, initial R, f functions.BiFunction[R, T, R]) R {
 	return result
 }
 
+// FoldErr applies a fallible bi-function to each element of a slice, starting from an initial value,
+// and returns the final result. It stops at the first error.
+//
+// Parameters:
+// - arr: the input slice
+// - f: the bi-function to apply to each element
+// - initial: the initial value
+//
+// Returns:
+// - R: the final result, or the partial result before the failing element
+// - error: a gtools.IndexedError with the index of the failing element, or nil
+func FoldErr[T, R any](arr *[]T, initial R, f functions.BiFunction2[R, T, R, error]) (R, error) {
+	// Initialize the result with the initial value
+	result := initial
+
+	// Iterate over each element in the slice
+	for i, v := range *arr {
+		// Apply the bi-function to the current element and the previous result
+		r, err := f(result, v)
+		if err != nil {
+			// Stop at the first error, keeping the partial result
+			return result, gtools.NewIndexedError(i, err)
+		}
+		result = r
+	}
+
+	// Return the final result
+	return result, nil
+}
+
 // FoldRight applies a bi-function to each element of a slice in reverse order,
 // starting from an initial value, and returns the final result.
 //
@@ -3,8 +3,10 @@
 package arrays
 
 import (
+	"errors"
 	"fmt"
 	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"github.com/andrerrcosta2/gtools/pkg/gtools"
 	"github.com/andrerrcosta2/gtools/pkg/testdata/testcomparables"
 	"reflect"
 	"strings"
@@ -328,6 +330,27 @@ func TestFold(t *testing.T) {
 
 }
 
+func TestFoldErr(t *testing.T) {
+	arr := []int{4, 2, 0, 1}
+	safeDiv := func(acc, v int) (int, error) {
+		if v == 0 {
+			return 0, errors.New("division by zero")
+		}
+		return acc / v, nil
+	}
+
+	res, err := FoldErr(&arr, 64, safeDiv)
+	var ie *gtools.IndexedError
+	if !errors.As(err, &ie) || ie.Index != 2 || res != 8 {
+		t.Errorf("FoldErr() = %v, %v, want 8 and an error at index 2", res, err)
+	}
+
+	arr = arr[:2]
+	if res, err = FoldErr(&arr, 64, safeDiv); err != nil || res != 8 {
+		t.Errorf("FoldErr() = %v, %v, want 8", res, err)
+	}
+}
+
 func add(v int, acc int) int {
 	return acc + v
 }
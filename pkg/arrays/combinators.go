@@ -0,0 +1,234 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package arrays
+
+import (
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"github.com/andrerrcosta2/gtools/pkg/tuple"
+)
+
+// Chunk splits a slice into consecutive chunks of n elements. The last chunk has the remaining elements.
+// The chunks share the backing array of the input slice, but appending to one doesn't overwrite the next.
+// It panics if n isn't positive.
+//
+// Parameters:
+// - arr: the input slice
+// - n: the size of the chunks
+//
+// Returns:
+// - [][]T: the chunks, in order
+func Chunk[T any](arr *[]T, n int) [][]T {
+	if n <= 0 {
+		panic(fmt.Sprintf("chunk size must be positive, got %d", n))
+	}
+	work := *arr
+	chunks := make([][]T, 0, (len(work)+n-1)/n)
+	for i := 0; i < len(work); i += n {
+		j := min(i+n, len(work))
+		// Limit the capacity so the chunks don't overlap
+		chunks = append(chunks, work[i:j:j])
+	}
+	return chunks
+}
+
+// Sliding returns the windows of size elements of a slice, each one starting step elements after the previous.
+// Windows shorter than size at the end of the slice are dropped. The windows share the backing array
+// of the input slice. It panics if size or step isn't positive.
+//
+// Parameters:
+// - arr: the input slice
+// - size: the size of the windows
+// - step: the distance between the start of two windows
+//
+// Returns:
+// - [][]T: the windows, in order
+func Sliding[T any](arr *[]T, size int, step int) [][]T {
+	if size <= 0 || step <= 0 {
+		panic(fmt.Sprintf("window size and step must be positive, got %d and %d", size, step))
+	}
+	work := *arr
+	windows := make([][]T, 0)
+	for i := 0; i+size <= len(work); i += step {
+		windows = append(windows, work[i:i+size:i+size])
+	}
+	return windows
+}
+
+// Zip pairs the elements of two slices by their index.
+// The result is as long as the shortest slice.
+//
+// Parameters:
+// - a: the slice of the first elements
+// - b: the slice of the second elements
+//
+// Returns:
+// - []*tuple.Pair[A, B]: the pairs, in order
+func Zip[A any, B any](a *[]A, b *[]B) []*tuple.Pair[A, B] {
+	n := min(len(*a), len(*b))
+	pairs := make([]*tuple.Pair[A, B], n)
+	for i := 0; i < n; i++ {
+		pairs[i] = tuple.NewPair((*a)[i], (*b)[i])
+	}
+	return pairs
+}
+
+// Zip3 groups the elements of three slices by their index.
+// The result is as long as the shortest slice.
+func Zip3[A any, B any, C any](a *[]A, b *[]B, c *[]C) []*tuple.Triple[A, B, C] {
+	n := min(len(*a), len(*b), len(*c))
+	triples := make([]*tuple.Triple[A, B, C], n)
+	for i := 0; i < n; i++ {
+		triples[i] = tuple.NewTriple((*a)[i], (*b)[i], (*c)[i])
+	}
+	return triples
+}
+
+// Unzip splits a slice of pairs into the slices of their first and second elements.
+//
+// Parameters:
+// - pairs: the input pairs
+//
+// Returns:
+// - []A: the first elements, in order
+// - []B: the second elements, in order
+func Unzip[A any, B any](pairs *[]*tuple.Pair[A, B]) ([]A, []B) {
+	a := make([]A, len(*pairs))
+	b := make([]B, len(*pairs))
+	for i, p := range *pairs {
+		a[i], b[i] = p.First(), p.Second()
+	}
+	return a, b
+}
+
+// Partition splits a slice by the provided function, keeping the order of the elements.
+//
+// Parameters:
+// - arr: the input slice
+// - f: a function that takes an element of type T and returns a boolean
+//
+// Returns:
+// - []T: the elements for which f returns true
+// - []T: the elements for which f returns false
+func Partition[T any](arr *[]T, f functions.Predicate[T]) ([]T, []T) {
+	matched, unmatched := make([]T, 0), make([]T, 0)
+	for _, v := range *arr {
+		if f(v) {
+			matched = append(matched, v)
+		} else {
+			unmatched = append(unmatched, v)
+		}
+	}
+	return matched, unmatched
+}
+
+// GroupBy groups the elements of a slice by the key returned by the provided function.
+// The elements of each group keep their order.
+//
+// Parameters:
+// - arr: the input slice
+// - f: a function that takes an element of type T and returns a key of type K
+//
+// Returns:
+// - map[K][]T: the groups by their key
+func GroupBy[T any, K comparable](arr *[]T, f functions.Function[T, K]) map[K][]T {
+	groups := make(map[K][]T)
+	for _, v := range *arr {
+		k := f(v)
+		groups[k] = append(groups[k], v)
+	}
+	return groups
+}
+
+// CountBy counts the elements of a slice by the key returned by the provided function.
+//
+// Parameters:
+// - arr: the input slice
+// - f: a function that takes an element of type T and returns a key of type K
+//
+// Returns:
+// - map[K]int: the number of elements by their key
+func CountBy[T any, K comparable](arr *[]T, f functions.Function[T, K]) map[K]int {
+	counts := make(map[K]int)
+	for _, v := range *arr {
+		counts[f(v)]++
+	}
+	return counts
+}
+
+// Interleave merges slices by taking one element of each of them in turn.
+// Once a slice is exhausted, the others continue in the same order.
+//
+// Example: Interleave(&[]int{1, 2, 3}, &[]int{4}, &[]int{5, 6}) returns [1 4 5 2 6 3].
+func Interleave[T any](arrs ...*[]T) []T {
+	n, longest := 0, 0
+	for _, arr := range arrs {
+		n += len(*arr)
+		longest = max(longest, len(*arr))
+	}
+	result := make([]T, 0, n)
+	for i := 0; i < longest; i++ {
+		for _, arr := range arrs {
+			if i < len(*arr) {
+				result = append(result, (*arr)[i])
+			}
+		}
+	}
+	return result
+}
+
+// Flatten concatenates the slices of a slice into a new slice.
+func Flatten[T any](arr *[][]T) []T {
+	n := 0
+	for _, inner := range *arr {
+		n += len(inner)
+	}
+	result := make([]T, 0, n)
+	for _, inner := range *arr {
+		result = append(result, inner...)
+	}
+	return result
+}
+
+// Rotate rotates the elements of a slice k positions to the left, in place.
+// A negative k rotates to the right.
+//
+// Parameters:
+// - arr: The slice to be rotated.
+// - k: The number of positions.
+//
+// Returns:
+// - The rotated slice.
+func Rotate[T any](arr *[]T, k int) []T {
+	a := *arr
+	if len(a) == 0 {
+		return a
+	}
+	k %= len(a)
+	if k < 0 {
+		k += len(a)
+	}
+	// Reversing both parts and then the whole slice moves the first k elements to the end
+	Reverse(a[:k])
+	Reverse(a[k:])
+	return Reverse(a)
+}
+
+// Cartesian returns the cartesian product of two slices: every pair of an element of a and an element of b,
+// ordered by the elements of a, then by the elements of b.
+//
+// Parameters:
+// - a: the slice of the first elements
+// - b: the slice of the second elements
+//
+// Returns:
+// - []*tuple.Pair[A, B]: the len(a) * len(b) pairs
+func Cartesian[A any, B any](a *[]A, b *[]B) []*tuple.Pair[A, B] {
+	pairs := make([]*tuple.Pair[A, B], 0, len(*a)*len(*b))
+	for _, x := range *a {
+		for _, y := range *b {
+			pairs = append(pairs, tuple.NewPair(x, y))
+		}
+	}
+	return pairs
+}
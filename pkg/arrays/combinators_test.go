@@ -0,0 +1,130 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package arrays
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestChunk(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5}
+	chunks := Chunk(&arr, 2)
+	if !reflect.DeepEqual(chunks, [][]int{{1, 2}, {3, 4}, {5}}) {
+		t.Errorf("Chunk() = %v, want [[1 2] [3 4] [5]]", chunks)
+	}
+
+	// Appending to a chunk doesn't overwrite the next one
+	_ = append(chunks[0], 9)
+	if chunks[1][0] != 3 {
+		t.Errorf("appending to a chunk changed the next one: %v", chunks)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Chunk() with a zero size didn't panic")
+		}
+	}()
+	Chunk(&arr, 0)
+}
+
+func TestSliding(t *testing.T) {
+	arr := []int{1, 2, 3, 4, 5, 6}
+	if got := Sliding(&arr, 3, 1); !reflect.DeepEqual(got, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}, {4, 5, 6}}) {
+		t.Errorf("Sliding(3, 1) = %v", got)
+	}
+	if got := Sliding(&arr, 2, 3); !reflect.DeepEqual(got, [][]int{{1, 2}, {4, 5}}) {
+		t.Errorf("Sliding(2, 3) = %v, want [[1 2] [4 5]]", got)
+	}
+	if got := Sliding(&arr, 7, 1); len(got) != 0 {
+		t.Errorf("Sliding(7, 1) = %v, want no windows", got)
+	}
+}
+
+func TestZipUnzip(t *testing.T) {
+	ids := []int{1, 2, 3}
+	names := []string{"a", "b"}
+
+	pairs := Zip(&ids, &names)
+	if len(pairs) != 2 || pairs[1].First() != 2 || pairs[1].Second() != "b" {
+		t.Fatalf("Zip() returned %d pairs, want 2", len(pairs))
+	}
+
+	a, b := Unzip(&pairs)
+	if !reflect.DeepEqual(a, []int{1, 2}) || !reflect.DeepEqual(b, []string{"a", "b"}) {
+		t.Errorf("Unzip() = %v, %v", a, b)
+	}
+
+	flags := []bool{true, false, true}
+	triples := Zip3(&ids, &names, &flags)
+	if len(triples) != 2 || triples[0].Third() != true {
+		t.Errorf("Zip3() returned %d triples, want 2", len(triples))
+	}
+}
+
+func TestPartitionGroupCount(t *testing.T) {
+	words := []string{"go", "rust", "c", "java", "zig"}
+
+	short, long := Partition(&words, func(w string) bool { return len(w) < 3 })
+	if !reflect.DeepEqual(short, []string{"go", "c"}) || !reflect.DeepEqual(long, []string{"rust", "java", "zig"}) {
+		t.Errorf("Partition() = %v, %v", short, long)
+	}
+
+	length := func(w string) int { return len(w) }
+	groups := GroupBy(&words, length)
+	if !reflect.DeepEqual(groups[4], []string{"rust", "java"}) || len(groups) != 4 {
+		t.Errorf("GroupBy() = %v", groups)
+	}
+
+	counts := CountBy(&words, length)
+	if counts[4] != 2 || counts[3] != 1 {
+		t.Errorf("CountBy() = %v", counts)
+	}
+}
+
+func TestInterleaveFlatten(t *testing.T) {
+	if got := Interleave(&[]int{1, 2, 3}, &[]int{4}, &[]int{5, 6}); !reflect.DeepEqual(got, []int{1, 4, 5, 2, 6, 3}) {
+		t.Errorf("Interleave() = %v, want [1 4 5 2 6 3]", got)
+	}
+
+	nested := [][]int{{1}, {}, {2, 3}}
+	if got := Flatten(&nested); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("Flatten() = %v, want [1 2 3]", got)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	tests := []struct {
+		k    int
+		want []int
+	}{
+		{0, []int{1, 2, 3, 4, 5}},
+		{2, []int{3, 4, 5, 1, 2}},
+		{-1, []int{5, 1, 2, 3, 4}},
+		{7, []int{3, 4, 5, 1, 2}},
+	}
+	for _, tt := range tests {
+		arr := []int{1, 2, 3, 4, 5}
+		if got := Rotate(&arr, tt.k); !reflect.DeepEqual(got, tt.want) || !reflect.DeepEqual(arr, tt.want) {
+			t.Errorf("Rotate(%d) = %v, want %v in place", tt.k, arr, tt.want)
+		}
+	}
+
+	var empty []int
+	if got := Rotate(&empty, 3); len(got) != 0 {
+		t.Errorf("Rotate() of an empty slice = %v", got)
+	}
+}
+
+func TestCartesian(t *testing.T) {
+	a := []string{"x", "y"}
+	b := []int{1, 2, 3}
+
+	pairs := Cartesian(&a, &b)
+	if len(pairs) != 6 {
+		t.Fatalf("Cartesian() returned %d pairs, want 6", len(pairs))
+	}
+	if p := pairs[4]; p.First() != "y" || p.Second() != 2 {
+		t.Errorf("Cartesian()[4] = (%v, %v), want (y, 2)", p.First(), p.Second())
+	}
+}
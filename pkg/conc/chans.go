@@ -0,0 +1,266 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package conc
+
+import (
+	"context"
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"iter"
+	"sync"
+	"time"
+)
+
+// The functions below build channel pipelines. Each of them starts the goroutines feeding
+// the returned channels, which are closed once their input is drained or the context is done,
+// so the stages of a pipeline can be stopped at once by cancelling the context.
+
+// Generator returns a channel receiving the values.
+func Generator[T any](ctx context.Context, values ...T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for _, v := range values {
+			if !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// GeneratorOf returns a channel receiving the values of the sequence.
+// It stops pulling from the sequence once the context is done, so it can be infinite.
+func GeneratorOf[T any](ctx context.Context, seq iter.Seq[T]) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for v := range seq {
+			if !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// OrDone returns a channel receiving the values of in until in is closed or the context is done.
+// It's useful to range over a channel which doesn't observe the context.
+func OrDone[T any](ctx context.Context, in <-chan T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := recv(ctx, in)
+			if !ok || !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Stage returns a channel receiving the result of f for each value of in, in order.
+func Stage[T any, R any](ctx context.Context, in <-chan T, f functions.Function[T, R]) <-chan R {
+	return MapChan(ctx, in, 1, f)
+}
+
+// MapChan returns a channel receiving the result of f for each value of in, calling f
+// from n workers. The results are sent as soon as they're ready, so they're out of order
+// when n is greater than 1.
+func MapChan[T any, R any](ctx context.Context, in <-chan T, n int, f functions.Function[T, R]) <-chan R {
+	if n < 1 {
+		n = 1
+	}
+	out := make(chan R)
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			for {
+				v, ok := recv(ctx, in)
+				if !ok || !send(ctx, out, f(v)) {
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanOut returns n channels sharing the values of in, each value being received by only one of them.
+// A slow consumer doesn't hold the others back, as the values go to whichever channel is ready.
+func FanOut[T any](ctx context.Context, in <-chan T, n int) []<-chan T {
+	outs := make([]<-chan T, n)
+	for i := range outs {
+		outs[i] = OrDone(ctx, in)
+	}
+	return outs
+}
+
+// FanIn returns a channel receiving the values of every channel, until all of them are closed.
+// The values of each channel keep their order, but the channels are interleaved.
+func FanIn[T any](ctx context.Context, chans ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(len(chans))
+	for _, ch := range chans {
+		go func(ch <-chan T) {
+			defer wg.Done()
+			for {
+				v, ok := recv(ctx, ch)
+				if !ok || !send(ctx, out, v) {
+					return
+				}
+			}
+		}(ch)
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// Merge is an alias of FanIn.
+func Merge[T any](ctx context.Context, chans ...<-chan T) <-chan T {
+	return FanIn(ctx, chans...)
+}
+
+// Tee returns two channels, both receiving every value of in.
+// Each value is sent to both channels before the next one is received,
+// so the slower consumer sets the pace of the other.
+func Tee[T any](ctx context.Context, in <-chan T) (<-chan T, <-chan T) {
+	out1, out2 := make(chan T), make(chan T)
+	go func() {
+		defer close(out1)
+		defer close(out2)
+		for {
+			v, ok := recv(ctx, in)
+			if !ok {
+				return
+			}
+			// A nil channel blocks, so each channel is disabled once it has received the value
+			o1, o2 := out1, out2
+			for o1 != nil || o2 != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case o1 <- v:
+					o1 = nil
+				case o2 <- v:
+					o2 = nil
+				}
+			}
+		}
+	}()
+	return out1, out2
+}
+
+// Batch returns a channel receiving the values of in grouped in slices of up to size values.
+// A partial batch is sent once maxWait has passed since its first value, unless maxWait is zero,
+// and when in is closed.
+func Batch[T any](ctx context.Context, in <-chan T, size int, maxWait time.Duration) <-chan []T {
+	if size < 1 {
+		size = 1
+	}
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+		var batch []T
+		// The timer channel is nil, and blocks, while there's no partial batch
+		var timer *time.Timer
+		var expired <-chan time.Time
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, expired = nil, nil
+			}
+			if len(batch) == 0 {
+				return true
+			}
+			b := batch
+			batch = nil
+			return send(ctx, out, b)
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				batch = append(batch, v)
+				if len(batch) >= size {
+					if !flush() {
+						return
+					}
+				} else if len(batch) == 1 && maxWait > 0 {
+					timer = time.NewTimer(maxWait)
+					expired = timer.C
+				}
+			case <-expired:
+				if !flush() {
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
+
+// Throttle returns a channel receiving the values of in, at most one every interval.
+// The values are delayed, not dropped, so the producer is slowed down.
+func Throttle[T any](ctx context.Context, in <-chan T, interval time.Duration) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		var last time.Time
+		for {
+			v, ok := recv(ctx, in)
+			if !ok {
+				return
+			}
+			if wait := interval - time.Since(last); !last.IsZero() && wait > 0 {
+				timer := time.NewTimer(wait)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return
+				case <-timer.C:
+				}
+			}
+			if !send(ctx, out, v) {
+				return
+			}
+			last = time.Now()
+		}
+	}()
+	return out
+}
+
+// send sends v to the channel, returning false if the context is done first.
+func send[T any](ctx context.Context, ch chan<- T, v T) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case ch <- v:
+		return true
+	}
+}
+
+// recv receives a value from the channel, returning false if it's closed or the context is done first.
+func recv[T any](ctx context.Context, ch <-chan T) (T, bool) {
+	select {
+	case <-ctx.Done():
+		return *new(T), false
+	case v, ok := <-ch:
+		return v, ok
+	}
+}
@@ -0,0 +1,115 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package conc
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"sort"
+	"testing"
+	"time"
+)
+
+func collect[T any](ch <-chan T) []T {
+	var values []T
+	for v := range ch {
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestPipeline_Stages(t *testing.T) {
+	ctx := context.Background()
+	double := func(v int) int { return v * 2 }
+
+	got := collect(Stage(ctx, Generator(ctx, 1, 2, 3, 4), double))
+	if !reflect.DeepEqual(got, []int{2, 4, 6, 8}) {
+		t.Errorf("Stage() = %v, want [2 4 6 8]", got)
+	}
+
+	got = collect(MapChan(ctx, Generator(ctx, 1, 2, 3, 4, 5, 6), 3, double))
+	sort.Ints(got)
+	if !reflect.DeepEqual(got, []int{2, 4, 6, 8, 10, 12}) {
+		t.Errorf("MapChan() = %v, want [2 4 6 8 10 12] in any order", got)
+	}
+
+	outs := FanOut(ctx, Generator(ctx, 1, 2, 3, 4, 5, 6, 7, 8), 3)
+	got = collect(Merge(ctx, outs...))
+	sort.Ints(got)
+	if !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5, 6, 7, 8}) {
+		t.Errorf("Merge(FanOut()) = %v, want [1 ... 8] in any order", got)
+	}
+}
+
+func TestTee(t *testing.T) {
+	ctx := context.Background()
+	a, b := Tee(ctx, Generator(ctx, "x", "y", "z"))
+
+	done := make(chan []string)
+	go func() { done <- collect(b) }()
+	if got := collect(a); !reflect.DeepEqual(got, []string{"x", "y", "z"}) {
+		t.Errorf("Tee() first channel = %v, want [x y z]", got)
+	}
+	if got := <-done; !reflect.DeepEqual(got, []string{"x", "y", "z"}) {
+		t.Errorf("Tee() second channel = %v, want [x y z]", got)
+	}
+}
+
+func TestBatch(t *testing.T) {
+	ctx := context.Background()
+	got := collect(Batch(ctx, Generator(ctx, 1, 2, 3, 4, 5), 2, 0))
+	if !reflect.DeepEqual(got, [][]int{{1, 2}, {3, 4}, {5}}) {
+		t.Errorf("Batch() = %v, want [[1 2] [3 4] [5]]", got)
+	}
+
+	// A partial batch is flushed after maxWait even if the input stays open
+	in := make(chan int)
+	batches := Batch(ctx, in, 10, 20*time.Millisecond)
+	in <- 7
+	select {
+	case b := <-batches:
+		if !reflect.DeepEqual(b, []int{7}) {
+			t.Errorf("Batch() = %v, want [7]", b)
+		}
+	case <-time.After(time.Second):
+		t.Errorf("Batch() didn't flush the partial batch")
+	}
+	close(in)
+}
+
+func TestThrottle(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now()
+	got := collect(Throttle(ctx, Generator(ctx, 1, 2, 3, 4), 10*time.Millisecond))
+	if len(got) != 4 {
+		t.Errorf("Throttle() = %v, want 4 values", got)
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("Throttle() took %v, want at least 30ms", elapsed)
+	}
+}
+
+func TestPipeline_Cancel(t *testing.T) {
+	before := runtime.NumGoroutine()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	naturals := func(yield func(int) bool) {
+		for i := 0; yield(i); i++ {
+		}
+	}
+	out := OrDone(ctx, MapChan(ctx, GeneratorOf(ctx, naturals), 4, func(v int) int { return v + 1 }))
+	<-out
+	<-out
+	cancel()
+	for range out {
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := runtime.NumGoroutine(); n > before {
+		t.Errorf("%d goroutines leaked after the cancellation", n-before)
+	}
+}
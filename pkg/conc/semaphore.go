@@ -2,7 +2,10 @@
 
 package conc
 
-import "sync"
+import (
+	"context"
+	"sync"
+)
 
 // NewChannelSemaphore returns a new semaphore that can be used to limit the number of concurrent operations.
 // The semaphore is initialized with a buffer of size maxConcurrent, allowing up to maxConcurrent operations to proceed concurrently.
@@ -25,6 +28,17 @@ func (s *ChannelSemaphore) Acq() {
 	s.ch <- struct{}{}
 }
 
+// AcqCtx acquires the semaphore like Acq, but gives up once the context is done.
+// It returns the context error if the semaphore wasn't acquired.
+func (s *ChannelSemaphore) AcqCtx(ctx context.Context) error {
+	select {
+	case s.ch <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // Rls releases a semaphore, allowing another operation to proceed.
 // It blocks until a slot is available in the semaphore's buffer.
 func (s *ChannelSemaphore) Rls() {
@@ -3,6 +3,8 @@
 package conc
 
 import (
+	"context"
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -38,6 +40,26 @@ func TestSemaphore_AcquireRelease(t *testing.T) {
 	wg.Wait()
 }
 
+// TestSemaphore_AcqCtx tests that acquiring a full semaphore gives up once the context is done.
+func TestSemaphore_AcqCtx(t *testing.T) {
+	// Create a full semaphore with a capacity of 1
+	sem := NewChannelSemaphore(1)
+	sem.Acq()
+
+	// Acquiring it fails with the context error once the context times out
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := sem.AcqCtx(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("AcqCtx() = %v, want context.DeadlineExceeded", err)
+	}
+
+	// Acquiring it succeeds once it's released
+	sem.Rls()
+	if err := sem.AcqCtx(context.Background()); err != nil {
+		t.Errorf("AcqCtx() = %v, want nil", err)
+	}
+}
+
 // TestSemaphore_OverCapacity tests the behavior of the semaphore when its capacity is exceeded.
 // It verifies that the second goroutine blocks until the first goroutine releases the semaphore.
 func TestSemaphore_OverCapacity(t *testing.T) {
@@ -0,0 +1,335 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+// Package bitset provides a compact, dynamically sized set of non-negative integers,
+// storing one bit per possible member.
+package bitset
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"iter"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+// wordSize is the number of bits in each word of the set
+const wordSize = 64
+
+// minJSONBits is the number of bits a JSON array can always address when decoded,
+// whatever its length. Larger indexes are bounded by the length of the array.
+const minJSONBits = 1 << 24
+
+var (
+	// ErrCorrupted is returned when decoding malformed binary data.
+	ErrCorrupted = errors.New("corrupted bitset data")
+	// ErrTooLarge is returned when decoding a JSON array whose indexes would need
+	// much more memory than the array itself.
+	ErrTooLarge = errors.New("bitset index too large to decode")
+)
+
+// Of creates a new Bitset with the given bits set.
+func Of(indexes ...uint) *Bitset {
+	b := &Bitset{}
+	for _, i := range indexes {
+		b.Set(i)
+	}
+	return b
+}
+
+// WithCapacity creates a new, empty Bitset with room for n bits before it needs to grow.
+func WithCapacity(n uint) *Bitset {
+	return &Bitset{words: make([]uint64, words(n))}
+}
+
+// Bitset is a set of non-negative integers stored as bits.
+// It grows as needed when bits are set, so its memory usage is proportional to the highest member.
+// The zero value is an empty set ready to use.
+// This isn't Thread-Safe.
+type Bitset struct {
+	words []uint64
+}
+
+// Set adds the bit to the set, growing it if needed.
+func (b *Bitset) Set(i uint) {
+	w := i / wordSize
+	if w >= uint(len(b.words)) {
+		b.grow(w + 1)
+	}
+	b.words[w] |= 1 << (i % wordSize)
+}
+
+// Clear removes the bit from the set.
+func (b *Bitset) Clear(i uint) {
+	if w := i / wordSize; w < uint(len(b.words)) {
+		b.words[w] &^= 1 << (i % wordSize)
+	}
+}
+
+// Test checks if the bit is in the set.
+func (b *Bitset) Test(i uint) bool {
+	w := i / wordSize
+	return w < uint(len(b.words)) && b.words[w]&(1<<(i%wordSize)) != 0
+}
+
+// Flip toggles the bit, growing the set if needed.
+func (b *Bitset) Flip(i uint) {
+	w := i / wordSize
+	if w >= uint(len(b.words)) {
+		b.grow(w + 1)
+	}
+	b.words[w] ^= 1 << (i % wordSize)
+}
+
+// Reset removes every bit from the set, keeping its capacity.
+func (b *Bitset) Reset() {
+	clear(b.words)
+}
+
+// Count returns the number of bits in the set.
+func (b *Bitset) Count() int {
+	n := 0
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// Cap returns the number of bits the set can hold before it needs to grow.
+func (b *Bitset) Cap() uint {
+	return uint(len(b.words)) * wordSize
+}
+
+// Any checks if at least one bit is set.
+func (b *Bitset) Any() bool {
+	for _, w := range b.words {
+		if w != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// NextSet returns the first set bit greater than or equal to i.
+// The bits can be iterated with:
+//
+//	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) { ... }
+//
+// Returns:
+// - uint: The index of the bit.
+// - bool: True if such a bit exists, false otherwise.
+func (b *Bitset) NextSet(i uint) (uint, bool) {
+	w := i / wordSize
+	if w >= uint(len(b.words)) {
+		return 0, false
+	}
+
+	// Ignore the bits before i in the first word
+	word := b.words[w] >> (i % wordSize)
+	if word != 0 {
+		return i + uint(bits.TrailingZeros64(word)), true
+	}
+	for w++; w < uint(len(b.words)); w++ {
+		if b.words[w] != 0 {
+			return w*wordSize + uint(bits.TrailingZeros64(b.words[w])), true
+		}
+	}
+	return 0, false
+}
+
+// NextClear returns the first clear bit greater than or equal to i.
+// Since the set is unbounded, such a bit always exists.
+func (b *Bitset) NextClear(i uint) uint {
+	w := i / wordSize
+	if w >= uint(len(b.words)) {
+		return i
+	}
+
+	// Ignore the bits before i in the first word
+	word := ^b.words[w] >> (i % wordSize)
+	if word != 0 {
+		return i + uint(bits.TrailingZeros64(word))
+	}
+	for w++; w < uint(len(b.words)); w++ {
+		if b.words[w] != ^uint64(0) {
+			return w*wordSize + uint(bits.TrailingZeros64(^b.words[w]))
+		}
+	}
+	return uint(len(b.words)) * wordSize
+}
+
+// Indexes returns the set bits in ascending order.
+func (b *Bitset) Indexes() []uint {
+	out := make([]uint, 0, b.Count())
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		out = append(out, i)
+	}
+	return out
+}
+
+// All returns a sequence of the set bits in ascending order.
+func (b *Bitset) All() iter.Seq[uint] {
+	return func(yield func(uint) bool) {
+		for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+// Clone returns a copy of the set.
+func (b *Bitset) Clone() *Bitset {
+	words := make([]uint64, len(b.words))
+	copy(words, b.words)
+	return &Bitset{words: words}
+}
+
+// Equal checks if both sets hold the same bits, regardless of their capacities.
+func (b *Bitset) Equal(o *Bitset) bool {
+	short, long := b.words, o.words
+	if len(short) > len(long) {
+		short, long = long, short
+	}
+	for i, w := range short {
+		if w != long[i] {
+			return false
+		}
+	}
+	for _, w := range long[len(short):] {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// And returns a new set with the bits present in both sets.
+func (b *Bitset) And(o *Bitset) *Bitset {
+	out := &Bitset{words: make([]uint64, min(len(b.words), len(o.words)))}
+	for i := range out.words {
+		out.words[i] = b.words[i] & o.words[i]
+	}
+	return out
+}
+
+// Or returns a new set with the bits present in any of the sets.
+func (b *Bitset) Or(o *Bitset) *Bitset {
+	out := b.Clone()
+	out.grow(uint(len(o.words)))
+	for i, w := range o.words {
+		out.words[i] |= w
+	}
+	return out
+}
+
+// Xor returns a new set with the bits present in exactly one of the sets.
+func (b *Bitset) Xor(o *Bitset) *Bitset {
+	out := b.Clone()
+	out.grow(uint(len(o.words)))
+	for i, w := range o.words {
+		out.words[i] ^= w
+	}
+	return out
+}
+
+// AndNot returns a new set with the bits present in this set but not in the other.
+func (b *Bitset) AndNot(o *Bitset) *Bitset {
+	out := b.Clone()
+	for i := 0; i < min(len(out.words), len(o.words)); i++ {
+		out.words[i] &^= o.words[i]
+	}
+	return out
+}
+
+// MarshalBinary encodes the set as its words in little-endian order.
+func (b *Bitset) MarshalBinary() ([]byte, error) {
+	// Trailing empty words aren't worth storing
+	n := len(b.words)
+	for n > 0 && b.words[n-1] == 0 {
+		n--
+	}
+
+	out := make([]byte, n*8)
+	for i, w := range b.words[:n] {
+		binary.LittleEndian.PutUint64(out[i*8:], w)
+	}
+	return out, nil
+}
+
+// UnmarshalBinary decodes a set encoded by MarshalBinary, replacing the current bits.
+func (b *Bitset) UnmarshalBinary(data []byte) error {
+	if len(data)%8 != 0 {
+		return ErrCorrupted
+	}
+	words := make([]uint64, len(data)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[i*8:])
+	}
+	b.words = words
+	return nil
+}
+
+// MarshalJSON encodes the set as a JSON array of its set bits in ascending order.
+func (b *Bitset) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.Indexes())
+}
+
+// UnmarshalJSON decodes a JSON array of bits, replacing the current bits.
+//
+// As each index needs the memory of every lower one, the indexes are bounded so the set
+// takes at most 2 MiB or eight times the length of the data, whichever is larger.
+// A larger index fails with ErrTooLarge, leaving the current bits untouched.
+func (b *Bitset) UnmarshalJSON(data []byte) error {
+	var indexes []uint
+	if err := json.Unmarshal(data, &indexes); err != nil {
+		return err
+	}
+	limit := max(uint(minJSONBits), uint(len(data))*wordSize)
+	for _, i := range indexes {
+		if i >= limit {
+			return ErrTooLarge
+		}
+	}
+	b.words = nil
+	for _, i := range indexes {
+		b.Set(i)
+	}
+	return nil
+}
+
+func (b *Bitset) String() string {
+	var sb strings.Builder
+	sb.WriteByte('{')
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		if sb.Len() > 1 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(strconv.FormatUint(uint64(i), 10))
+	}
+	sb.WriteByte('}')
+	return sb.String()
+}
+
+// grow ensures the set holds at least n words.
+func (b *Bitset) grow(n uint) {
+	if n <= uint(len(b.words)) {
+		return
+	}
+	if n <= uint(cap(b.words)) {
+		old := len(b.words)
+		b.words = b.words[:n]
+		clear(b.words[old:])
+		return
+	}
+	// Double the capacity to amortize consecutive growths
+	words := make([]uint64, n, max(n, uint(2*cap(b.words))))
+	copy(words, b.words)
+	b.words = words
+}
+
+// words returns the number of words needed to hold n bits.
+func words(n uint) uint {
+	return (n + wordSize - 1) / wordSize
+}
@@ -0,0 +1,143 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package bitset
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBitset_SetClearTest(t *testing.T) {
+	b := Of(1, 63, 64, 1000)
+
+	for _, i := range []uint{1, 63, 64, 1000} {
+		if !b.Test(i) {
+			t.Errorf("Test(%d) = false, want true", i)
+		}
+	}
+	for _, i := range []uint{0, 2, 65, 999, 1 << 20} {
+		if b.Test(i) {
+			t.Errorf("Test(%d) = true, want false", i)
+		}
+	}
+	if b.Count() != 4 {
+		t.Errorf("Count() = %d, want 4", b.Count())
+	}
+
+	b.Clear(64)
+	b.Clear(1 << 20)
+	b.Flip(2)
+	b.Flip(63)
+	if !reflect.DeepEqual(b.Indexes(), []uint{1, 2, 1000}) {
+		t.Errorf("Indexes() = %v, want [1 2 1000]", b.Indexes())
+	}
+
+	b.Reset()
+	if b.Any() || b.Count() != 0 {
+		t.Errorf("Reset() left bits in the set")
+	}
+}
+
+func TestBitset_Next(t *testing.T) {
+	b := Of(0, 1, 2, 64, 130)
+
+	var got []uint
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		got = append(got, i)
+	}
+	if !reflect.DeepEqual(got, []uint{0, 1, 2, 64, 130}) {
+		t.Errorf("NextSet() iteration = %v", got)
+	}
+	if _, ok := b.NextSet(131); ok {
+		t.Errorf("NextSet(131) found a bit past the last one")
+	}
+
+	tests := []struct{ from, want uint }{{0, 3}, {64, 65}, {130, 131}, {500, 500}}
+	for _, tt := range tests {
+		if got := b.NextClear(tt.from); got != tt.want {
+			t.Errorf("NextClear(%d) = %d, want %d", tt.from, got, tt.want)
+		}
+	}
+
+	full := &Bitset{}
+	for i := uint(0); i < 128; i++ {
+		full.Set(i)
+	}
+	if got := full.NextClear(0); got != 128 {
+		t.Errorf("NextClear(0) on a full set = %d, want 128", got)
+	}
+}
+
+func TestBitset_Algebra(t *testing.T) {
+	a := Of(1, 2, 3, 200)
+	b := Of(2, 3, 4)
+
+	tests := []struct {
+		name string
+		got  *Bitset
+		want []uint
+	}{
+		{"And", a.And(b), []uint{2, 3}},
+		{"Or", a.Or(b), []uint{1, 2, 3, 4, 200}},
+		{"Xor", a.Xor(b), []uint{1, 4, 200}},
+		{"AndNot", a.AndNot(b), []uint{1, 200}},
+		{"AndNot reversed", b.AndNot(a), []uint{4}},
+	}
+	for _, tt := range tests {
+		if !reflect.DeepEqual(tt.got.Indexes(), tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, tt.got.Indexes(), tt.want)
+		}
+	}
+
+	// The operands are left untouched
+	if !reflect.DeepEqual(a.Indexes(), []uint{1, 2, 3, 200}) {
+		t.Errorf("an operation modified its operand: %v", a.Indexes())
+	}
+	if !Of(5).Equal(WithCapacity(1000).Or(Of(5))) {
+		t.Errorf("Equal() depends on the capacity")
+	}
+}
+
+func TestBitset_Marshalling(t *testing.T) {
+	b := Of(3, 70, 511)
+
+	data, _ := b.MarshalBinary()
+	loaded := &Bitset{}
+	if err := loaded.UnmarshalBinary(data); err != nil || !loaded.Equal(b) {
+		t.Errorf("UnmarshalBinary() = %v, %v, want %v", loaded, err, b)
+	}
+	if err := loaded.UnmarshalBinary(data[:5]); !errors.Is(err, ErrCorrupted) {
+		t.Errorf("UnmarshalBinary() = %v, want ErrCorrupted", err)
+	}
+
+	js, _ := json.Marshal(b)
+	if string(js) != "[3,70,511]" {
+		t.Errorf("Marshal() = %s, want [3,70,511]", js)
+	}
+	var decoded Bitset
+	if err := json.Unmarshal(js, &decoded); err != nil || !decoded.Equal(b) {
+		t.Errorf("Unmarshal() = %v, %v, want %v", &decoded, err, b)
+	}
+	if b.String() != "{3, 70, 511}" {
+		t.Errorf("String() = %s", b.String())
+	}
+}
+
+func TestBitset_UnmarshalJSONBoundsTheIndexes(t *testing.T) {
+	b := Of(1)
+	if err := json.Unmarshal([]byte("[2, 1000000000000000000]"), b); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Unmarshal() = %v, want ErrTooLarge", err)
+	}
+	if !b.Equal(Of(1)) {
+		t.Errorf("Unmarshal() changed the set to %v after failing", b)
+	}
+
+	if err := json.Unmarshal([]byte("[16777215]"), b); err != nil || !b.Equal(Of(16777215)) {
+		t.Errorf("Unmarshal() = %v, %v, want {16777215}", b, err)
+	}
+	if err := json.Unmarshal([]byte("[16777216]"), b); !errors.Is(err, ErrTooLarge) {
+		t.Errorf("Unmarshal() = %v, want ErrTooLarge", err)
+	}
+}
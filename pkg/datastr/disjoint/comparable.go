@@ -0,0 +1,29 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package disjoint
+
+import "github.com/andrerrcosta2/gtools/pkg/functions"
+
+// Comparable creates a new ComparableForest with every given value as a singleton set.
+//
+// Elements are identified by their own value, so they are stored as map keys.
+func Comparable[T comparable](values ...T) *ComparableForest[T] {
+	// The element is its own key
+	f := &ComparableForest[T]{
+		forest: newForest[T, T](functions.Identity[T]),
+	}
+
+	// Add each value as a singleton set.
+	for _, v := range values {
+		f.Add(v)
+	}
+
+	return f
+}
+
+// ComparableForest is a disjoint-set forest of comparable elements.
+type ComparableForest[T comparable] struct {
+	forest[T, T]
+}
+
+var _ Forest[string] = (*ComparableForest[string])(nil)
@@ -0,0 +1,197 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+// Package disjoint provides disjoint-set forests, also known as union-find structures.
+//
+// Every operation runs in O(α(n)) amortized time, where α is the inverse Ackermann function,
+// thanks to path compression on Find and union by rank on Union.
+// These structures aren't Thread-Safe.
+package disjoint
+
+import (
+	"iter"
+)
+
+// Forest is the interface implemented by the disjoint-set forests of this package.
+type Forest[T any] interface {
+	// Add inserts the element as a singleton set if it doesn't exist yet.
+	Add(t T)
+	// Has checks if the element belongs to the forest.
+	Has(t T) bool
+	// Find returns the representative of the set containing the element.
+	Find(t T) (T, bool)
+	// Union merges the sets containing both elements, adding them if needed.
+	// It returns false if both elements were already in the same set.
+	Union(a, b T) bool
+	// Connected checks if both elements belong to the same set.
+	Connected(a, b T) bool
+	// SetSize returns the size of the set containing the element.
+	SetSize(t T) int
+	// Groups returns the elements partitioned by their sets.
+	Groups() [][]T
+	// Len returns the number of elements in the forest.
+	Len() int
+	// Count returns the number of disjoint sets in the forest.
+	Count() int
+}
+
+// forest is the shared implementation of the disjoint-set forests.
+// Elements are indexed by a comparable key and stored in parallel slices,
+// so the trees are represented by indexes instead of pointers.
+type forest[T any, K comparable] struct {
+	key    func(T) K
+	index  map[K]int
+	items  []T
+	parent []int
+	rank   []int
+	size   []int
+	count  int
+}
+
+func newForest[T any, K comparable](key func(T) K) forest[T, K] {
+	return forest[T, K]{
+		key:   key,
+		index: make(map[K]int),
+	}
+}
+
+func (f *forest[T, K]) Add(t T) {
+	f.add(t)
+}
+
+// add inserts the element if it doesn't exist yet and returns its index.
+func (f *forest[T, K]) add(t T) int {
+	k := f.key(t)
+	if i, ok := f.index[k]; ok {
+		return i
+	}
+
+	// A new element is the root of its own singleton set
+	i := len(f.items)
+	f.index[k] = i
+	f.items = append(f.items, t)
+	f.parent = append(f.parent, i)
+	f.rank = append(f.rank, 0)
+	f.size = append(f.size, 1)
+	f.count++
+	return i
+}
+
+func (f *forest[T, K]) Has(t T) bool {
+	_, ok := f.index[f.key(t)]
+	return ok
+}
+
+func (f *forest[T, K]) Find(t T) (T, bool) {
+	i, ok := f.index[f.key(t)]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return f.items[f.root(i)], true
+}
+
+// root returns the index of the root of the tree containing i.
+// Every node visited on the way is re-parented to the root (path compression).
+func (f *forest[T, K]) root(i int) int {
+	// First pass: find the root
+	r := i
+	for f.parent[r] != r {
+		r = f.parent[r]
+	}
+
+	// Second pass: compress the path
+	for f.parent[i] != r {
+		next := f.parent[i]
+		f.parent[i] = r
+		i = next
+	}
+	return r
+}
+
+func (f *forest[T, K]) Union(a, b T) bool {
+	ra, rb := f.root(f.add(a)), f.root(f.add(b))
+	if ra == rb {
+		return false
+	}
+
+	// Attach the shallower tree under the deeper one (union by rank)
+	if f.rank[ra] < f.rank[rb] {
+		ra, rb = rb, ra
+	}
+	f.parent[rb] = ra
+	f.size[ra] += f.size[rb]
+	if f.rank[ra] == f.rank[rb] {
+		f.rank[ra]++
+	}
+	f.count--
+	return true
+}
+
+func (f *forest[T, K]) Connected(a, b T) bool {
+	ia, ok := f.index[f.key(a)]
+	if !ok {
+		return false
+	}
+	ib, ok := f.index[f.key(b)]
+	if !ok {
+		return false
+	}
+	return f.root(ia) == f.root(ib)
+}
+
+func (f *forest[T, K]) SetSize(t T) int {
+	i, ok := f.index[f.key(t)]
+	if !ok {
+		return 0
+	}
+	return f.size[f.root(i)]
+}
+
+// Groups returns the elements partitioned by their sets.
+// Groups are ordered by the insertion of their first element, and elements keep their insertion order.
+func (f *forest[T, K]) Groups() [][]T {
+	groups := make([][]T, 0, f.count)
+	// Maps the root index to the position of its group in the output
+	positions := make(map[int]int, f.count)
+
+	for i, item := range f.items {
+		r := f.root(i)
+		pos, ok := positions[r]
+		if !ok {
+			pos = len(groups)
+			positions[r] = pos
+			groups = append(groups, make([]T, 0, f.size[r]))
+		}
+		groups[pos] = append(groups[pos], item)
+	}
+	return groups
+}
+
+// All returns a sequence of the elements in insertion order.
+func (f *forest[T, K]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range f.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+func (f *forest[T, K]) Len() int {
+	return len(f.items)
+}
+
+func (f *forest[T, K]) Count() int {
+	return f.count
+}
+
+// Clear removes every element from the forest.
+func (f *forest[T, K]) Clear() {
+	f.index = make(map[K]int)
+	f.items = nil
+	f.parent = nil
+	f.rank = nil
+	f.size = nil
+	f.count = 0
+}
@@ -0,0 +1,110 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package disjoint
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/testdata/testsortables"
+	"reflect"
+	"testing"
+)
+
+func TestComparableForest_Union(t *testing.T) {
+	f := Comparable(1, 2, 3, 4, 5)
+
+	if f.Count() != 5 || f.Len() != 5 {
+		t.Fatalf("Count() = %d, Len() = %d, want 5, 5", f.Count(), f.Len())
+	}
+
+	if !f.Union(1, 2) || !f.Union(3, 4) || !f.Union(2, 4) {
+		t.Fatalf("Union() of disjoint sets returned false")
+	}
+	if f.Union(1, 3) {
+		t.Errorf("Union() of connected elements returned true")
+	}
+
+	if !f.Connected(1, 4) {
+		t.Errorf("Connected(1, 4) = false, want true")
+	}
+	if f.Connected(1, 5) {
+		t.Errorf("Connected(1, 5) = true, want false")
+	}
+	if f.SetSize(3) != 4 || f.SetSize(5) != 1 {
+		t.Errorf("SetSize() = %d, %d, want 4, 1", f.SetSize(3), f.SetSize(5))
+	}
+	if f.Count() != 2 {
+		t.Errorf("Count() = %d, want 2", f.Count())
+	}
+
+	r1, _ := f.Find(1)
+	r4, _ := f.Find(4)
+	if r1 != r4 {
+		t.Errorf("Find() returned different representatives %d and %d for the same set", r1, r4)
+	}
+}
+
+func TestComparableForest_UnknownElements(t *testing.T) {
+	f := Comparable[string]()
+
+	if _, ok := f.Find("a"); ok {
+		t.Errorf("Find() of an unknown element returned true")
+	}
+	if f.Connected("a", "a") {
+		t.Errorf("Connected() of unknown elements returned true")
+	}
+	if f.SetSize("a") != 0 {
+		t.Errorf("SetSize() of an unknown element = %d, want 0", f.SetSize("a"))
+	}
+
+	// Union adds the missing elements
+	f.Union("a", "b")
+	if !f.Has("a") || !f.Has("b") || !f.Connected("a", "b") {
+		t.Errorf("Union() didn't add and connect the missing elements")
+	}
+}
+
+func TestComparableForest_Groups(t *testing.T) {
+	f := Comparable("alice", "bob", "carol", "dave", "eve")
+	f.Union("alice", "carol")
+	f.Union("dave", "bob")
+	f.Union("eve", "alice")
+
+	exp := [][]string{{"alice", "carol", "eve"}, {"bob", "dave"}}
+	if got := f.Groups(); !reflect.DeepEqual(got, exp) {
+		t.Errorf("Groups() = %v, want %v", got, exp)
+	}
+
+	f.Clear()
+	if f.Len() != 0 || f.Count() != 0 || len(f.Groups()) != 0 {
+		t.Errorf("Clear() didn't empty the forest")
+	}
+}
+
+func TestComparableForest_LongChain(t *testing.T) {
+	f := Comparable[int]()
+	for i := 0; i < 10000; i++ {
+		f.Union(i, i+1)
+	}
+	if f.Count() != 1 || f.SetSize(0) != 10001 {
+		t.Errorf("Count() = %d, SetSize() = %d, want 1, 10001", f.Count(), f.SetSize(0))
+	}
+}
+
+func TestSortableOfForest(t *testing.T) {
+	nodes := testsortables.RandomTestNodes(6, "node").Values()
+	f := SortableOf(nodes...)
+
+	f.Union(nodes[0], nodes[1])
+	f.Union(nodes[2], nodes[3])
+	f.Union(nodes[1], nodes[3])
+
+	// Equal values are recognized through their hash
+	if !f.Connected(testsortables.TestNode("node_0"), testsortables.TestNode("node_2")) {
+		t.Errorf("Connected() = false for elements of the same set")
+	}
+	if f.Connected(nodes[4], nodes[5]) {
+		t.Errorf("Connected() = true for elements of different sets")
+	}
+	if f.Count() != 3 {
+		t.Errorf("Count() = %d, want 3", f.Count())
+	}
+}
@@ -0,0 +1,34 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package disjoint
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/gtools"
+	"github.com/andrerrcosta2/gtools/pkg/sortables"
+)
+
+// SortableOf creates a new SortableOfForest with every given value as a singleton set.
+//
+// Elements are identified by the stable hash of sortables.ComparatorOf, so values
+// implementing gtools.PersistentSortableOf are recognized even when their address changes.
+func SortableOf[T gtools.SortableOf](values ...T) *SortableOfForest[T] {
+	// The comparator provides the hash used as the key of each element.
+	comparator := sortables.ComparatorOf[T]()
+	f := &SortableOfForest[T]{
+		forest: newForest[T, string](comparator.Hash),
+	}
+
+	// Add each value as a singleton set.
+	for _, v := range values {
+		f.Add(v)
+	}
+
+	return f
+}
+
+// SortableOfForest is a disjoint-set forest of gtools.SortableOf elements.
+type SortableOfForest[T gtools.SortableOf] struct {
+	forest[T, string]
+}
+
+var _ Forest[gtools.SortableOf] = (*SortableOfForest[gtools.SortableOf])(nil)
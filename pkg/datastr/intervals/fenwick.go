@@ -0,0 +1,115 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package intervals
+
+import (
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/constraints"
+	"iter"
+)
+
+// Fenwick creates a new FenwickTree of n elements, all set to zero.
+func Fenwick[T constraints.Numeric](n int) *FenwickTree[T] {
+	return &FenwickTree[T]{
+		tree: make([]T, n+1),
+	}
+}
+
+// FenwickOf creates a new FenwickTree holding a copy of the values.
+// It's built in O(n), instead of the O(n log n) of adding the values one by one.
+func FenwickOf[T constraints.Numeric](values []T) *FenwickTree[T] {
+	f := Fenwick[T](len(values))
+	copy(f.tree[1:], values)
+
+	// Each node pushes its partial sum to the next node covering it
+	for i := 1; i < len(f.tree); i++ {
+		if j := i + i&-i; j < len(f.tree) {
+			f.tree[j] += f.tree[i]
+		}
+	}
+	return f
+}
+
+// FenwickTree, also known as binary indexed tree, keeps the prefix sums of a numeric slice,
+// so both point updates and range sums run in O(log n).
+// Indexes are zero-based and ranges are half-open, like Go slices.
+// This isn't Thread-Safe.
+type FenwickTree[T constraints.Numeric] struct {
+	// tree is one-based, tree[i] holds the sum of the i&-i elements ending at i
+	tree []T
+}
+
+// Len returns the number of elements.
+func (f *FenwickTree[T]) Len() int {
+	return len(f.tree) - 1
+}
+
+// Add adds the delta to the element at the index.
+// It panics if the index is out of range.
+func (f *FenwickTree[T]) Add(index int, delta T) {
+	f.check(index)
+	for i := index + 1; i < len(f.tree); i += i & -i {
+		f.tree[i] += delta
+	}
+}
+
+// Set replaces the element at the index.
+// It panics if the index is out of range.
+func (f *FenwickTree[T]) Set(index int, value T) {
+	f.Add(index, value-f.Get(index))
+}
+
+// Get returns the element at the index.
+// It panics if the index is out of range.
+func (f *FenwickTree[T]) Get(index int) T {
+	f.check(index)
+	return f.RangeSum(index, index+1)
+}
+
+// PrefixSum returns the sum of the first n elements, that is, the elements in [0, n).
+// It panics if n is negative or greater than Len.
+func (f *FenwickTree[T]) PrefixSum(n int) T {
+	if n < 0 || n > f.Len() {
+		panic(fmt.Sprintf("prefix length %d out of range [0, %d]", n, f.Len()))
+	}
+	var sum T
+	for i := n; i > 0; i -= i & -i {
+		sum += f.tree[i]
+	}
+	return sum
+}
+
+// RangeSum returns the sum of the elements in [lo, hi).
+// It panics if the range is invalid.
+func (f *FenwickTree[T]) RangeSum(lo, hi int) T {
+	if lo > hi {
+		panic(fmt.Sprintf("invalid range [%d, %d)", lo, hi))
+	}
+	return f.PrefixSum(hi) - f.PrefixSum(lo)
+}
+
+// Values returns the elements as a new slice.
+func (f *FenwickTree[T]) Values() []T {
+	out := make([]T, f.Len())
+	for i := range out {
+		out[i] = f.Get(i)
+	}
+	return out
+}
+
+// All returns a sequence of the indexes and elements.
+func (f *FenwickTree[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < f.Len(); i++ {
+			if !yield(i, f.Get(i)) {
+				return
+			}
+		}
+	}
+}
+
+func (f *FenwickTree[T]) check(index int) {
+	if index < 0 || index >= f.Len() {
+		panic(fmt.Sprintf("index %d out of range [0, %d)", index, f.Len()))
+	}
+}
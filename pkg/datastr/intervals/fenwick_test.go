@@ -0,0 +1,66 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package intervals
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestFenwickTree_Sums(t *testing.T) {
+	values := []int{3, 1, 4, 1, 5, 9, 2, 6}
+	f := FenwickOf(values)
+
+	for n := 0; n <= len(values); n++ {
+		want := 0
+		for _, v := range values[:n] {
+			want += v
+		}
+		if got := f.PrefixSum(n); got != want {
+			t.Errorf("PrefixSum(%d) = %d, want %d", n, got, want)
+		}
+	}
+
+	if got := f.RangeSum(2, 5); got != 10 {
+		t.Errorf("RangeSum(2, 5) = %d, want 10", got)
+	}
+	if !reflect.DeepEqual(f.Values(), values) {
+		t.Errorf("Values() = %v, want %v", f.Values(), values)
+	}
+}
+
+func TestFenwickTree_Updates(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	values := make([]float64, 100)
+	f := Fenwick[float64](len(values))
+
+	for i := 0; i < 1000; i++ {
+		idx := r.Intn(len(values))
+		if r.Intn(2) == 0 {
+			f.Add(idx, 1.5)
+			values[idx] += 1.5
+		} else {
+			f.Set(idx, float64(i))
+			values[idx] = float64(i)
+		}
+	}
+
+	lo, hi := 17, 83
+	want := 0.0
+	for _, v := range values[lo:hi] {
+		want += v
+	}
+	if got := f.RangeSum(lo, hi); got != want {
+		t.Errorf("RangeSum(%d, %d) = %v, want %v", lo, hi, got, want)
+	}
+}
+
+func TestFenwickTree_OutOfRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Add() didn't panic on an index out of range")
+		}
+	}()
+	Fenwick[int](3).Add(3, 1)
+}
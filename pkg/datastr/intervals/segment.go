@@ -0,0 +1,111 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package intervals
+
+import (
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"iter"
+)
+
+// Segment creates a new SegmentTree holding a copy of the values.
+//
+// Parameters:
+// - values: The initial elements.
+// - combine: An associative function merging two aggregates, like sum, min, max or gcd.
+// It doesn't need to be commutative, the left aggregate is always the first argument.
+// - identity: The aggregate of an empty range, such that combine(identity, x) == x.
+//
+// Returns:
+// - *SegmentTree[T]: The tree, built in O(n).
+func Segment[T any](values []T, combine functions.BiFunction[T, T, T], identity T) *SegmentTree[T] {
+	n := len(values)
+	s := &SegmentTree[T]{
+		tree:     make([]T, 2*n),
+		n:        n,
+		combine:  combine,
+		identity: identity,
+	}
+
+	// The leaves live in the second half, and each parent aggregates its two children
+	copy(s.tree[n:], values)
+	for i := n - 1; i > 0; i-- {
+		s.tree[i] = combine(s.tree[2*i], s.tree[2*i+1])
+	}
+	return s
+}
+
+// SegmentTree answers aggregate queries over any range of a slice in O(log n),
+// while supporting point updates in O(log n).
+// Indexes are zero-based and ranges are half-open, like Go slices.
+// This isn't Thread-Safe.
+type SegmentTree[T any] struct {
+	// tree is a bottom-up segment tree, where the node i has the children 2i and 2i+1
+	tree     []T
+	n        int
+	combine  functions.BiFunction[T, T, T]
+	identity T
+}
+
+// Len returns the number of elements.
+func (s *SegmentTree[T]) Len() int {
+	return s.n
+}
+
+// Get returns the element at the index.
+// It panics if the index is out of range.
+func (s *SegmentTree[T]) Get(index int) T {
+	s.check(index)
+	return s.tree[index+s.n]
+}
+
+// Set replaces the element at the index and updates the aggregates covering it.
+// It panics if the index is out of range.
+func (s *SegmentTree[T]) Set(index int, value T) {
+	s.check(index)
+	i := index + s.n
+	s.tree[i] = value
+	for i > 1 {
+		i /= 2
+		s.tree[i] = s.combine(s.tree[2*i], s.tree[2*i+1])
+	}
+}
+
+// Query returns the aggregate of the elements in [lo, hi), or the identity if the range is empty.
+// It panics if the range is invalid.
+func (s *SegmentTree[T]) Query(lo, hi int) T {
+	if lo < 0 || hi > s.n || lo > hi {
+		panic(fmt.Sprintf("invalid range [%d, %d) for length %d", lo, hi, s.n))
+	}
+
+	// The left and right aggregates are kept apart to preserve the order of the elements
+	left, right := s.identity, s.identity
+	for l, r := lo+s.n, hi+s.n; l < r; l, r = l/2, r/2 {
+		if l&1 == 1 {
+			left = s.combine(left, s.tree[l])
+			l++
+		}
+		if r&1 == 1 {
+			r--
+			right = s.combine(s.tree[r], right)
+		}
+	}
+	return s.combine(left, right)
+}
+
+// All returns a sequence of the indexes and elements.
+func (s *SegmentTree[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, v := range s.tree[s.n:] {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+func (s *SegmentTree[T]) check(index int) {
+	if index < 0 || index >= s.n {
+		panic(fmt.Sprintf("index %d out of range [0, %d)", index, s.n))
+	}
+}
@@ -0,0 +1,54 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package intervals
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSegmentTree_Min(t *testing.T) {
+	values := []int{5, 2, 8, 6, 3, 7, 1, 4, 9}
+	s := Segment(values, func(a, b int) int { return min(a, b) }, math.MaxInt)
+
+	for lo := 0; lo <= len(values); lo++ {
+		for hi := lo; hi <= len(values); hi++ {
+			want := math.MaxInt
+			for _, v := range values[lo:hi] {
+				want = min(want, v)
+			}
+			if got := s.Query(lo, hi); got != want {
+				t.Errorf("Query(%d, %d) = %d, want %d", lo, hi, got, want)
+			}
+		}
+	}
+
+	s.Set(6, 10)
+	if got := s.Query(4, 8); got != 3 {
+		t.Errorf("Query(4, 8) = %d after Set(), want 3", got)
+	}
+	if s.Get(6) != 10 {
+		t.Errorf("Get(6) = %d, want 10", s.Get(6))
+	}
+}
+
+func TestSegmentTree_KeepsOrder(t *testing.T) {
+	// Concatenation isn't commutative, so any reordering would show up
+	values := []string{"a", "b", "c", "d", "e", "f", "g"}
+	s := Segment(values, func(a, b string) string { return a + b }, "")
+
+	tests := []struct {
+		lo, hi int
+		want   string
+	}{
+		{0, 7, "abcdefg"},
+		{1, 6, "bcdef"},
+		{3, 4, "d"},
+		{2, 2, ""},
+	}
+	for _, tt := range tests {
+		if got := s.Query(tt.lo, tt.hi); got != tt.want {
+			t.Errorf("Query(%d, %d) = %q, want %q", tt.lo, tt.hi, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,359 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+// Package intervals provides structures for range queries: an interval tree for
+// overlap and stabbing queries, and Fenwick and segment trees for range aggregates
+// over slices.
+package intervals
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/constraints"
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"iter"
+)
+
+// Interval is the closed range [Lo, Hi].
+type Interval[T constraints.Ordered] struct {
+	Lo T
+	Hi T
+}
+
+// Overlaps checks if both intervals share at least one point.
+// Intervals touching on an endpoint overlap, since both are closed.
+func (i Interval[T]) Overlaps(o Interval[T]) bool {
+	return i.Lo <= o.Hi && o.Lo <= i.Hi
+}
+
+// Contains checks if the point lies within the interval.
+func (i Interval[T]) Contains(point T) bool {
+	return i.Lo <= point && point <= i.Hi
+}
+
+// Entry is an interval stored in an IntervalTree along with its value.
+type Entry[T constraints.Ordered, V any] struct {
+	Interval[T]
+	Value V
+}
+
+// Tree creates a new, empty IntervalTree.
+//
+// The tree is an AVL tree ordered by the lower endpoint, then by the higher endpoint,
+// where each node is augmented with the highest endpoint of its subtree.
+// Insert and Delete run in O(log n), and overlap queries run in O(log n + k)
+// where k is the number of reported intervals.
+func Tree[T constraints.Ordered, V any]() *IntervalTree[T, V] {
+	return &IntervalTree[T, V]{}
+}
+
+// IntervalTree maps closed intervals with endpoints of type T to values of type V.
+// Each distinct interval holds a single value, like the keys of a map.
+// This isn't Thread-Safe.
+type IntervalTree[T constraints.Ordered, V any] struct {
+	root *inode[T, V]
+	size int
+}
+
+type inode[T constraints.Ordered, V any] struct {
+	iv    Interval[T]
+	value V
+	// max is the highest endpoint of the subtree rooted at this node
+	max    T
+	height int
+	left   *inode[T, V]
+	right  *inode[T, V]
+}
+
+// Len returns the number of intervals stored in the tree.
+func (t *IntervalTree[T, V]) Len() int {
+	return t.size
+}
+
+// Clear removes every interval from the tree.
+func (t *IntervalTree[T, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Insert adds the interval [lo, hi] to the tree.
+// If the endpoints are reversed, they're swapped. If the interval already exists, its value is replaced.
+//
+// Parameters:
+// - lo: The lower endpoint of the interval.
+// - hi: The higher endpoint of the interval.
+// - value: The value associated with the interval.
+//
+// Returns:
+// - V: The previous value of the interval, or the zero value if it didn't exist.
+// - bool: True if an existing value was replaced, false otherwise.
+func (t *IntervalTree[T, V]) Insert(lo, hi T, value V) (V, bool) {
+	iv := interval(lo, hi)
+
+	if n := t.find(iv); n != nil {
+		old := n.value
+		n.value = value
+		return old, true
+	}
+
+	t.root = t.insert(t.root, iv, value)
+	t.size++
+	var zero V
+	return zero, false
+}
+
+// Get returns the value associated with the interval [lo, hi].
+//
+// Returns:
+// - V: The value of the interval, or the zero value if it doesn't exist.
+// - bool: True if the interval exists, false otherwise.
+func (t *IntervalTree[T, V]) Get(lo, hi T) (V, bool) {
+	if n := t.find(interval(lo, hi)); n != nil {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains checks if the interval [lo, hi] exists in the tree.
+func (t *IntervalTree[T, V]) Contains(lo, hi T) bool {
+	return t.find(interval(lo, hi)) != nil
+}
+
+// Delete removes the interval [lo, hi] from the tree.
+//
+// Returns:
+// - V: The removed value, or the zero value if the interval didn't exist.
+// - bool: True if the interval was removed, false otherwise.
+func (t *IntervalTree[T, V]) Delete(lo, hi T) (V, bool) {
+	iv := interval(lo, hi)
+
+	n := t.find(iv)
+	if n == nil {
+		var zero V
+		return zero, false
+	}
+
+	old := n.value
+	t.root = t.delete(t.root, iv)
+	t.size--
+	return old, true
+}
+
+// Overlapping returns the intervals sharing at least one point with [lo, hi],
+// sorted by their lower endpoints, then by their higher endpoints.
+func (t *IntervalTree[T, V]) Overlapping(lo, hi T) []Entry[T, V] {
+	var out []Entry[T, V]
+	t.WalkOverlapping(lo, hi, func(iv Interval[T], v V) bool {
+		out = append(out, Entry[T, V]{Interval: iv, Value: v})
+		return true
+	})
+	return out
+}
+
+// Stabbing returns the intervals containing the point,
+// sorted by their lower endpoints, then by their higher endpoints.
+func (t *IntervalTree[T, V]) Stabbing(point T) []Entry[T, V] {
+	return t.Overlapping(point, point)
+}
+
+// Intersects checks if any interval shares at least one point with [lo, hi].
+// It's cheaper than Overlapping when only the existence of a conflict matters.
+func (t *IntervalTree[T, V]) Intersects(lo, hi T) bool {
+	found := false
+	t.WalkOverlapping(lo, hi, func(Interval[T], V) bool {
+		found = true
+		return false
+	})
+	return found
+}
+
+// WalkOverlapping calls the function for each interval sharing at least one point with [lo, hi],
+// in the same order as Overlapping. The walk stops when the function returns false.
+func (t *IntervalTree[T, V]) WalkOverlapping(lo, hi T, fn functions.BiPredicate[Interval[T], V]) {
+	walkOverlapping(t.root, interval(lo, hi), fn)
+}
+
+// Walk calls the function for each interval in the tree, in the same order as Overlapping.
+// The walk stops when the function returns false.
+func (t *IntervalTree[T, V]) Walk(fn functions.BiPredicate[Interval[T], V]) {
+	walk(t.root, fn)
+}
+
+// Entries returns every interval in the tree, in the same order as Overlapping.
+func (t *IntervalTree[T, V]) Entries() []Entry[T, V] {
+	out := make([]Entry[T, V], 0, t.size)
+	t.Walk(func(iv Interval[T], v V) bool {
+		out = append(out, Entry[T, V]{Interval: iv, Value: v})
+		return true
+	})
+	return out
+}
+
+// All returns a sequence of every interval and its value, in the same order as Overlapping.
+func (t *IntervalTree[T, V]) All() iter.Seq2[Interval[T], V] {
+	return func(yield func(Interval[T], V) bool) {
+		t.Walk(yield)
+	}
+}
+
+// OverlappingSeq returns a sequence of the intervals sharing at least one point with [lo, hi]
+// and their values, in the same order as Overlapping.
+func (t *IntervalTree[T, V]) OverlappingSeq(lo, hi T) iter.Seq2[Interval[T], V] {
+	return func(yield func(Interval[T], V) bool) {
+		t.WalkOverlapping(lo, hi, yield)
+	}
+}
+
+// interval builds an interval, swapping the endpoints if they're reversed.
+func interval[T constraints.Ordered](lo, hi T) Interval[T] {
+	if hi < lo {
+		lo, hi = hi, lo
+	}
+	return Interval[T]{Lo: lo, Hi: hi}
+}
+
+// compare orders the intervals by their lower endpoints, then by their higher endpoints.
+func compare[T constraints.Ordered](a, b Interval[T]) int {
+	switch {
+	case a.Lo < b.Lo:
+		return -1
+	case a.Lo > b.Lo:
+		return 1
+	case a.Hi < b.Hi:
+		return -1
+	case a.Hi > b.Hi:
+		return 1
+	}
+	return 0
+}
+
+func (t *IntervalTree[T, V]) find(iv Interval[T]) *inode[T, V] {
+	n := t.root
+	for n != nil {
+		switch c := compare(iv, n.iv); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return nil
+}
+
+// insert adds an interval known to be absent and returns the new root of the subtree.
+func (t *IntervalTree[T, V]) insert(n *inode[T, V], iv Interval[T], value V) *inode[T, V] {
+	if n == nil {
+		return &inode[T, V]{iv: iv, value: value, max: iv.Hi, height: 1}
+	}
+	if compare(iv, n.iv) < 0 {
+		n.left = t.insert(n.left, iv, value)
+	} else {
+		n.right = t.insert(n.right, iv, value)
+	}
+	return rebalance(n)
+}
+
+// delete removes an interval known to be present and returns the new root of the subtree.
+func (t *IntervalTree[T, V]) delete(n *inode[T, V], iv Interval[T]) *inode[T, V] {
+	switch c := compare(iv, n.iv); {
+	case c < 0:
+		n.left = t.delete(n.left, iv)
+	case c > 0:
+		n.right = t.delete(n.right, iv)
+	default:
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		// The node takes the place of its successor, which is then removed from the right subtree
+		succ := n.right
+		for succ.left != nil {
+			succ = succ.left
+		}
+		n.iv, n.value = succ.iv, succ.value
+		n.right = t.delete(n.right, succ.iv)
+	}
+	return rebalance(n)
+}
+
+func walkOverlapping[T constraints.Ordered, V any](n *inode[T, V], iv Interval[T], fn functions.BiPredicate[Interval[T], V]) bool {
+	// No interval in this subtree reaches the query
+	if n == nil || n.max < iv.Lo {
+		return true
+	}
+	if !walkOverlapping(n.left, iv, fn) {
+		return false
+	}
+	// This node and its right subtree start after the query ends
+	if n.iv.Lo > iv.Hi {
+		return true
+	}
+	if n.iv.Overlaps(iv) && !fn(n.iv, n.value) {
+		return false
+	}
+	return walkOverlapping(n.right, iv, fn)
+}
+
+func walk[T constraints.Ordered, V any](n *inode[T, V], fn functions.BiPredicate[Interval[T], V]) bool {
+	if n == nil {
+		return true
+	}
+	return walk(n.left, fn) && fn(n.iv, n.value) && walk(n.right, fn)
+}
+
+func height[T constraints.Ordered, V any](n *inode[T, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// update recomputes the height and the highest endpoint of the node from its children.
+func update[T constraints.Ordered, V any](n *inode[T, V]) {
+	n.height = 1 + max(height(n.left), height(n.right))
+	n.max = n.iv.Hi
+	if n.left != nil && n.left.max > n.max {
+		n.max = n.left.max
+	}
+	if n.right != nil && n.right.max > n.max {
+		n.max = n.right.max
+	}
+}
+
+// rebalance restores the AVL invariant on the node and returns the new root of the subtree.
+func rebalance[T constraints.Ordered, V any](n *inode[T, V]) *inode[T, V] {
+	update(n)
+	switch balance := height(n.left) - height(n.right); {
+	case balance > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case balance < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+func rotateLeft[T constraints.Ordered, V any](n *inode[T, V]) *inode[T, V] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	update(n)
+	update(r)
+	return r
+}
+
+func rotateRight[T constraints.Ordered, V any](n *inode[T, V]) *inode[T, V] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	update(n)
+	update(l)
+	return l
+}
@@ -0,0 +1,122 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package intervals
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestIntervalTree_InsertAndDelete(t *testing.T) {
+	tree := Tree[int, string]()
+
+	if _, replaced := tree.Insert(10, 20, "a"); replaced {
+		t.Errorf("Insert() reported a replacement on a new interval")
+	}
+	// Reversed endpoints are swapped
+	if old, replaced := tree.Insert(20, 10, "b"); !replaced || old != "a" {
+		t.Errorf("Insert() = %v, %v, want a, true", old, replaced)
+	}
+	tree.Insert(10, 30, "c")
+
+	if tree.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", tree.Len())
+	}
+	if v, ok := tree.Get(10, 20); !ok || v != "b" {
+		t.Errorf("Get(10, 20) = %v, %v, want b, true", v, ok)
+	}
+	if v, ok := tree.Delete(10, 20); !ok || v != "b" {
+		t.Errorf("Delete(10, 20) = %v, %v, want b, true", v, ok)
+	}
+	if _, ok := tree.Delete(10, 20); ok || tree.Contains(10, 20) || tree.Len() != 1 {
+		t.Errorf("the interval is still in the tree after Delete()")
+	}
+}
+
+func TestIntervalTree_Overlapping(t *testing.T) {
+	// Maintenance windows in minutes
+	tree := Tree[int, string]()
+	tree.Insert(0, 30, "backup")
+	tree.Insert(25, 40, "deploy")
+	tree.Insert(60, 90, "reindex")
+	tree.Insert(90, 95, "vacuum")
+
+	tests := []struct {
+		lo, hi int
+		want   []string
+	}{
+		{26, 29, []string{"backup", "deploy"}},
+		{41, 59, nil},
+		{40, 60, []string{"deploy", "reindex"}},
+		{90, 90, []string{"reindex", "vacuum"}},
+		{-10, 100, []string{"backup", "deploy", "reindex", "vacuum"}},
+	}
+
+	for _, tt := range tests {
+		var got []string
+		for _, e := range tree.Overlapping(tt.lo, tt.hi) {
+			got = append(got, e.Value)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Overlapping(%d, %d) = %v, want %v", tt.lo, tt.hi, got, tt.want)
+		}
+		if tree.Intersects(tt.lo, tt.hi) != (len(tt.want) > 0) {
+			t.Errorf("Intersects(%d, %d) = %v", tt.lo, tt.hi, !(len(tt.want) > 0))
+		}
+	}
+
+	if got := tree.Stabbing(30); len(got) != 2 || got[0].Value != "backup" || got[1].Value != "deploy" {
+		t.Errorf("Stabbing(30) = %v, want backup and deploy", got)
+	}
+}
+
+func TestIntervalTree_Random(t *testing.T) {
+	tree := Tree[int, int]()
+	live := make(map[Interval[int]]int)
+	r := rand.New(rand.NewSource(42))
+
+	// Random inserts and deletes, checked against a linear scan
+	for i := 0; i < 3000; i++ {
+		lo := r.Intn(1000)
+		iv := Interval[int]{Lo: lo, Hi: lo + r.Intn(50)}
+		if r.Intn(3) == 0 {
+			_, ok := tree.Delete(iv.Lo, iv.Hi)
+			if _, exists := live[iv]; ok != exists {
+				t.Fatalf("Delete(%v) = %v, want %v", iv, ok, exists)
+			}
+			delete(live, iv)
+		} else {
+			tree.Insert(iv.Lo, iv.Hi, i)
+			live[iv] = i
+		}
+	}
+
+	if tree.Len() != len(live) {
+		t.Fatalf("Len() = %d, want %d", tree.Len(), len(live))
+	}
+
+	for q := 0; q < 200; q++ {
+		lo := r.Intn(1100) - 50
+		query := Interval[int]{Lo: lo, Hi: lo + r.Intn(30)}
+
+		want := 0
+		for iv := range live {
+			if iv.Overlaps(query) {
+				want++
+			}
+		}
+		got := tree.Overlapping(query.Lo, query.Hi)
+		if len(got) != want {
+			t.Fatalf("Overlapping(%v) returned %d intervals, want %d", query, len(got), want)
+		}
+		for i, e := range got {
+			if !e.Overlaps(query) || live[e.Interval] != e.Value {
+				t.Errorf("Overlapping(%v) returned a wrong entry %v", query, e)
+			}
+			if i > 0 && compare(got[i-1].Interval, e.Interval) >= 0 {
+				t.Errorf("Overlapping(%v) isn't sorted", query)
+			}
+		}
+	}
+}
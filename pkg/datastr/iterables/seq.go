@@ -0,0 +1,191 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package iterables
+
+import (
+	"iter"
+)
+
+// FromIterator adapts an Iterator into an iter.Seq, so it can be used in range loops.
+// The sequence consumes the iterator, so it can only be ranged over once.
+func FromIterator[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v, ok := it.Next(); ok; v, ok = it.Next() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FromMapIterator adapts a MapIterator into an iter.Seq2, so it can be used in range loops.
+// The sequence consumes the iterator, so it can only be ranged over once.
+func FromMapIterator[K any, V any](it MapIterator[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v, ok := it.Next(); ok; k, v, ok = it.Next() {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// FromIterable adapts an Iterable into an iter.Seq, so it can be used in range loops.
+//
+// Ranging directly over Loop leaks the producer goroutine when the loop breaks early,
+// since nobody receives its next value. The sequence prevents that by draining the
+// channel in the background when the consumer stops, which lets the producer finish.
+func FromIterable[T any](it Iterable[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		ch := it.Loop()
+		for v := range ch {
+			if !yield(v) {
+				go func() {
+					for range ch {
+					}
+				}()
+				return
+			}
+		}
+	}
+}
+
+// ToIterator adapts an iter.Seq into a SeqIterator, for code written against the Iterator interface.
+// The iterator must be stopped if it isn't consumed until the end.
+func ToIterator[T any](seq iter.Seq[T]) *SeqIterator[T] {
+	next, stop := iter.Pull(seq)
+	return &SeqIterator[T]{next: next, stop: stop}
+}
+
+// ToMapIterator adapts an iter.Seq2 into a SeqMapIterator, for code written against the MapIterator interface.
+// The iterator must be stopped if it isn't consumed until the end.
+func ToMapIterator[K any, V any](seq iter.Seq2[K, V]) *SeqMapIterator[K, V] {
+	next, stop := iter.Pull2(seq)
+	return &SeqMapIterator[K, V]{next: next, stop: stop}
+}
+
+// SeqIterator is an Iterator pulling its values from an iter.Seq.
+type SeqIterator[T any] struct {
+	next func() (T, bool)
+	stop func()
+}
+
+func (it *SeqIterator[T]) Next() (T, bool) {
+	return it.next()
+}
+
+// Stop releases the resources of the underlying sequence.
+// It's safe to call Stop more than once, and Next returns false after it.
+func (it *SeqIterator[T]) Stop() {
+	it.stop()
+}
+
+var _ Iterator[string] = (*SeqIterator[string])(nil)
+
+// SeqMapIterator is a MapIterator pulling its pairs from an iter.Seq2.
+type SeqMapIterator[K any, V any] struct {
+	next func() (K, V, bool)
+	stop func()
+}
+
+func (it *SeqMapIterator[K, V]) Next() (K, V, bool) {
+	return it.next()
+}
+
+// Stop releases the resources of the underlying sequence.
+// It's safe to call Stop more than once, and Next returns false after it.
+func (it *SeqMapIterator[K, V]) Stop() {
+	it.stop()
+}
+
+var _ MapIterator[string, string] = (*SeqMapIterator[string, string])(nil)
+
+// All returns a sequence of the indexes and elements of the slice.
+func (s *Slice[G]) All() iter.Seq2[int, G] {
+	return func(yield func(int, G) bool) {
+		for i, v := range *s {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns a sequence of the elements of the slice.
+func (s *Slice[G]) ValuesSeq() iter.Seq[G] {
+	return func(yield func(G) bool) {
+		for _, v := range *s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns a sequence of the key-value pairs of the map, in no particular order.
+func (t *Map[G, K]) All() iter.Seq2[G, K] {
+	return func(yield func(G, K) bool) {
+		for k, v := range *t {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns a sequence of the keys of the map, in no particular order.
+func (t *Map[G, K]) KeysSeq() iter.Seq[G] {
+	return func(yield func(G) bool) {
+		for k := range *t {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns a sequence of the values of the map, in no particular order.
+func (t *Map[G, K]) ValuesSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, v := range *t {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns a sequence of the keys of the map and their slices, in no particular order.
+func (m *SliceMap[G, K]) All() iter.Seq2[G, []K] {
+	return func(yield func(G, []K) bool) {
+		for k, v := range *m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns a sequence of the keys of the map, in no particular order.
+func (m *SliceMap[G, K]) KeysSeq() iter.Seq[G] {
+	return func(yield func(G) bool) {
+		for k := range *m {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns a sequence of the elements of every slice, like Values does.
+func (m *SliceMap[G, K]) ValuesSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, vs := range *m {
+			for _, v := range vs {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package iterables
+
+import (
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+type sliceIterator struct {
+	values []int
+	i      int
+}
+
+func (s *sliceIterator) Next() (int, bool) {
+	if s.i >= len(s.values) {
+		return 0, false
+	}
+	s.i++
+	return s.values[s.i-1], true
+}
+
+type channelIterable struct {
+	values []int
+}
+
+func (c *channelIterable) Loop() <-chan int {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for _, v := range c.values {
+			ch <- v
+		}
+	}()
+	return ch
+}
+
+func TestFromIterator(t *testing.T) {
+	var got []int
+	for v := range FromIterator[int](&sliceIterator{values: []int{1, 2, 3, 4}}) {
+		if v == 3 {
+			break
+		}
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("FromIterator() = %v, want [1 2]", got)
+	}
+}
+
+func TestFromIterable_NoLeak(t *testing.T) {
+	values := make([]int, 1000)
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 50; i++ {
+		for v := range FromIterable[int](&channelIterable{values: values}) {
+			_ = v
+			break
+		}
+	}
+
+	// The producers finish once their channels are drained
+	deadline := time.Now().Add(2 * time.Second)
+	for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if n := runtime.NumGoroutine(); n > before {
+		t.Errorf("%d goroutines leaked", n-before)
+	}
+}
+
+func TestToIterator(t *testing.T) {
+	s := OfSlice(5, 6, 7)
+	it := ToIterator(s.ValuesSeq())
+	defer it.Stop()
+
+	var got []int
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, []int{5, 6, 7}) {
+		t.Errorf("ToIterator() = %v, want [5 6 7]", got)
+	}
+
+	// Stopping early ends the iteration
+	it = ToIterator(s.ValuesSeq())
+	it.Next()
+	it.Stop()
+	if _, ok := it.Next(); ok {
+		t.Errorf("Next() returned a value after Stop()")
+	}
+}
+
+func TestMapIteratorRoundTrip(t *testing.T) {
+	m := Map[string, int]{"a": 1, "b": 2}
+
+	got := map[string]int{}
+	for k, v := range FromMapIterator[string, int](ToMapIterator(m.All())) {
+		got[k] = v
+	}
+	if !reflect.DeepEqual(got, map[string]int(m)) {
+		t.Errorf("the round trip returned %v, want %v", got, m)
+	}
+}
+
+func TestSliceMap_Seqs(t *testing.T) {
+	m := SliceMap[string, int]{}
+	m.PutOrAppend("a", 1).PutOrAppend("a", 2).PutOrAppend("b", 3)
+
+	sum := 0
+	for v := range m.ValuesSeq() {
+		sum += v
+	}
+	if sum != 6 {
+		t.Errorf("ValuesSeq() summed %d, want 6", sum)
+	}
+	for k, vs := range m.All() {
+		if !reflect.DeepEqual(vs, m.At(k)) {
+			t.Errorf("All() yielded %v for %s, want %v", vs, k, m.At(k))
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package maps
+
+import (
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/comparables"
+	"github.com/andrerrcosta2/gtools/pkg/datastr/iterables"
+	"github.com/andrerrcosta2/gtools/pkg/sortables"
+	"github.com/andrerrcosta2/gtools/pkg/sorts"
+	"iter"
+)
+
+// Bi creates a new, empty BiMap.
+func Bi[K comparable, V comparable]() *BiMap[K, V] {
+	forward, backward := make(map[K]V), make(map[V]K)
+
+	// Both views share the same underlying maps
+	m := &BiMap[K, V]{forward: forward, backward: backward}
+	m.inverse = &BiMap[V, K]{forward: backward, backward: forward, inverse: m}
+	return m
+}
+
+// BiMap is a map that enforces a one-to-one relation between keys and values,
+// so values can be looked up by key and keys can be looked up by value in O(1).
+// This isn't Thread-Safe.
+type BiMap[K comparable, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+	inverse  *BiMap[V, K]
+}
+
+// Put binds the key to the value.
+// Any previous binding of the key or of the value is removed to keep the relation one-to-one.
+func (m *BiMap[K, V]) Put(key K, value V) {
+	// Unbind the previous value of the key
+	if old, ok := m.forward[key]; ok {
+		delete(m.backward, old)
+	}
+	// Unbind the previous key of the value
+	if old, ok := m.backward[value]; ok {
+		delete(m.forward, old)
+	}
+	m.forward[key] = value
+	m.backward[value] = key
+}
+
+// TryPut binds the key to the value, refusing to steal the value from another key.
+// The previous value of the key, if any, is replaced.
+//
+// Returns:
+// - An error if the value is already bound to a different key.
+func (m *BiMap[K, V]) TryPut(key K, value V) error {
+	if old, ok := m.backward[value]; ok && old != key {
+		return fmt.Errorf("value already exists: %v", value)
+	}
+	m.Put(key, value)
+	return nil
+}
+
+// Get returns the value bound to the key.
+func (m *BiMap[K, V]) Get(key K) (V, bool) {
+	v, ok := m.forward[key]
+	return v, ok
+}
+
+// GetKey returns the key bound to the value.
+func (m *BiMap[K, V]) GetKey(value V) (K, bool) {
+	k, ok := m.backward[value]
+	return k, ok
+}
+
+// Delete removes the key and its value.
+func (m *BiMap[K, V]) Delete(key K) {
+	if v, ok := m.forward[key]; ok {
+		delete(m.forward, key)
+		delete(m.backward, v)
+	}
+}
+
+// DeleteValue removes the value and its key.
+func (m *BiMap[K, V]) DeleteValue(value V) {
+	m.inverse.Delete(value)
+}
+
+// Contains checks if the key exists.
+func (m *BiMap[K, V]) Contains(key K) bool {
+	_, ok := m.forward[key]
+	return ok
+}
+
+// ContainsValue checks if the value exists.
+func (m *BiMap[K, V]) ContainsValue(value V) bool {
+	_, ok := m.backward[value]
+	return ok
+}
+
+// Len returns the number of bindings.
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// Clear removes every binding from the map and from its inverse view.
+func (m *BiMap[K, V]) Clear() {
+	// The maps are cleared in place, since they're shared with the inverse view
+	clear(m.forward)
+	clear(m.backward)
+}
+
+// Keys returns all the keys.
+// The keys aren't guaranteed to be in any particular order.
+func (m *BiMap[K, V]) Keys() []K {
+	out := make([]K, 0, len(m.forward))
+	for k := range m.forward {
+		out = append(out, k)
+	}
+	return out
+}
+
+// Values returns all the values.
+// The values aren't guaranteed to be in any particular order.
+func (m *BiMap[K, V]) Values() []V {
+	out := make([]V, 0, len(m.backward))
+	for v := range m.backward {
+		out = append(out, v)
+	}
+	return out
+}
+
+// Inverse returns the value-to-key view of the map.
+// The view is backed by the same data, so changes to either are visible through both.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return m.inverse
+}
+
+// Iterator the variadic parameter is just a trick to allow to use the iterator without requiring parameters.
+// its presence indicates the keys must be sorted.
+func (m *BiMap[K, V]) Iterator(comparator ...comparables.FunctionalComparator[K]) iterables.MapIterator[K, V] {
+	keys := m.Keys()
+
+	if len(comparator) > 0 {
+		sortables.Sort[K](&keys, sorts.NewQuicksort[K](comparator[0]))
+	}
+
+	return &BiMapIterator[K, V]{
+		m:    m,
+		keys: keys,
+	}
+}
+
+// All returns a sequence of the key-value pairs of the map, in no particular order.
+func (m *BiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m.forward {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns a sequence of the keys of the map, in no particular order.
+func (m *BiMap[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.forward {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns a sequence of the values of the map, in no particular order.
+func (m *BiMap[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range m.backward {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func (m *BiMap[K, V]) String() string {
+	return fmt.Sprintf("%v", m.forward)
+}
+
+var _ StructMap[string, string] = (*BiMap[string, string])(nil)
+
+type BiMapIterator[K comparable, V comparable] struct {
+	m       *BiMap[K, V]
+	keys    []K
+	current int
+}
+
+func (it *BiMapIterator[K, V]) Next() (key K, value V, ok bool) {
+	// Skip the keys removed after the iterator was created
+	for it.current < len(it.keys) {
+		key = it.keys[it.current]
+		it.current++
+		if value, ok = it.m.forward[key]; ok {
+			return
+		}
+	}
+	var zero K
+	return zero, value, false
+}
@@ -0,0 +1,90 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package maps
+
+import (
+	"testing"
+)
+
+func TestBiMap_PutAndGet(t *testing.T) {
+	m := Bi[int, string]()
+	m.Put(1, "alice")
+	m.Put(2, "bob")
+
+	if v, ok := m.Get(1); !ok || v != "alice" {
+		t.Errorf("Get(1) = %v, %v, want alice, true", v, ok)
+	}
+	if k, ok := m.GetKey("bob"); !ok || k != 2 {
+		t.Errorf("GetKey(bob) = %v, %v, want 2, true", k, ok)
+	}
+
+	// Rebinding a value removes its previous key
+	m.Put(3, "alice")
+	if m.Contains(1) {
+		t.Errorf("the previous key of the value is still bound")
+	}
+	// Rebinding a key removes its previous value
+	m.Put(3, "carol")
+	if m.ContainsValue("alice") {
+		t.Errorf("the previous value of the key is still bound")
+	}
+	if m.Len() != 2 || m.Inverse().Len() != 2 {
+		t.Errorf("Len() = %d, Inverse().Len() = %d, want 2, 2", m.Len(), m.Inverse().Len())
+	}
+}
+
+func TestBiMap_TryPut(t *testing.T) {
+	m := Bi[int, string]()
+	if err := m.TryPut(1, "alice"); err != nil {
+		t.Fatalf("TryPut() returned an error: %v", err)
+	}
+	if err := m.TryPut(2, "alice"); err == nil {
+		t.Errorf("TryPut() stole the value from another key")
+	}
+	if err := m.TryPut(1, "alice"); err != nil {
+		t.Errorf("TryPut() of an existing binding returned an error: %v", err)
+	}
+}
+
+func TestBiMap_InverseView(t *testing.T) {
+	m := Bi[int, string]()
+	inv := m.Inverse()
+
+	// Changes through the view are visible in the map and the other way around
+	inv.Put("alice", 1)
+	if v, _ := m.Get(1); v != "alice" {
+		t.Errorf("Get(1) = %v after putting through the inverse, want alice", v)
+	}
+	m.DeleteValue("alice")
+	if inv.Contains("alice") || m.Len() != 0 {
+		t.Errorf("DeleteValue() wasn't visible through the inverse")
+	}
+	if inv.Inverse() != m {
+		t.Errorf("the inverse of the inverse isn't the original map")
+	}
+
+	m.Put(1, "a")
+	inv.Clear()
+	if m.Len() != 0 {
+		t.Errorf("Clear() through the inverse left %d bindings", m.Len())
+	}
+}
+
+func TestBiMap_Iterator(t *testing.T) {
+	m := Bi[int, string]()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+
+	it := m.Iterator(func(a, b int) int { return a - b })
+	var keys []int
+	for k, v, ok := it.Next(); ok; k, v, ok = it.Next() {
+		if w, _ := m.Get(k); w != v {
+			t.Errorf("Iterator() returned %v for key %v, want %v", v, k, w)
+		}
+		keys = append(keys, k)
+	}
+	if len(keys) != 3 || keys[0] != 1 || keys[1] != 2 || keys[2] != 3 {
+		t.Errorf("Iterator() keys = %v, want [1 2 3]", keys)
+	}
+}
@@ -0,0 +1,392 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package maps
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/comparables"
+	"github.com/andrerrcosta2/gtools/pkg/datastr/iterables"
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"github.com/andrerrcosta2/gtools/pkg/sortables"
+	"github.com/andrerrcosta2/gtools/pkg/sorts"
+	"iter"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// Linked creates a new, empty LinkedMap.
+func Linked[K comparable, V any]() *LinkedMap[K, V] {
+	return &LinkedMap[K, V]{
+		index: make(map[K]*linkedEntry[K, V]),
+	}
+}
+
+// LinkedMap is a map that keeps its keys in insertion order.
+// Lookups, insertions, removals and moves run in O(1), and iteration, Keys, Values and
+// the JSON encoding all follow the order of the keys.
+// The zero value is an empty map ready to use.
+// This isn't Thread-Safe.
+type LinkedMap[K comparable, V any] struct {
+	index map[K]*linkedEntry[K, V]
+	// head and tail are the first and the last entries of the doubly linked list
+	head *linkedEntry[K, V]
+	tail *linkedEntry[K, V]
+}
+
+type linkedEntry[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *linkedEntry[K, V]
+	next  *linkedEntry[K, V]
+}
+
+// Put adds a new key-value pair at the back of the map.
+// If the key already exists, its value is replaced and it keeps its position.
+func (m *LinkedMap[K, V]) Put(key K, value V) {
+	if e, ok := m.index[key]; ok {
+		e.value = value
+		return
+	}
+	if m.index == nil {
+		m.index = make(map[K]*linkedEntry[K, V])
+	}
+	e := &linkedEntry[K, V]{key: key, value: value}
+	m.index[key] = e
+	m.pushBack(e)
+}
+
+func (m *LinkedMap[K, V]) Get(key K) (V, bool) {
+	if e, ok := m.index[key]; ok {
+		return e.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (m *LinkedMap[K, V]) Delete(key K) {
+	if e, ok := m.index[key]; ok {
+		m.unlink(e)
+		delete(m.index, key)
+	}
+}
+
+func (m *LinkedMap[K, V]) Contains(key K) bool {
+	_, ok := m.index[key]
+	return ok
+}
+
+func (m *LinkedMap[K, V]) Len() int {
+	return len(m.index)
+}
+
+func (m *LinkedMap[K, V]) Clear() {
+	m.index = make(map[K]*linkedEntry[K, V])
+	m.head, m.tail = nil, nil
+}
+
+// Keys returns the keys in order.
+func (m *LinkedMap[K, V]) Keys() []K {
+	out := make([]K, 0, len(m.index))
+	for e := m.head; e != nil; e = e.next {
+		out = append(out, e.key)
+	}
+	return out
+}
+
+// Values returns the values in the order of their keys.
+func (m *LinkedMap[K, V]) Values() []V {
+	out := make([]V, 0, len(m.index))
+	for e := m.head; e != nil; e = e.next {
+		out = append(out, e.value)
+	}
+	return out
+}
+
+// MoveToFront moves the key to the front of the map.
+//
+// Returns:
+// - bool: True if the key exists, false otherwise.
+func (m *LinkedMap[K, V]) MoveToFront(key K) bool {
+	e, ok := m.index[key]
+	if !ok {
+		return false
+	}
+	if e != m.head {
+		m.unlink(e)
+		m.pushFront(e)
+	}
+	return true
+}
+
+// MoveToBack moves the key to the back of the map.
+//
+// Returns:
+// - bool: True if the key exists, false otherwise.
+func (m *LinkedMap[K, V]) MoveToBack(key K) bool {
+	e, ok := m.index[key]
+	if !ok {
+		return false
+	}
+	if e != m.tail {
+		m.unlink(e)
+		m.pushBack(e)
+	}
+	return true
+}
+
+// Front returns the first key-value pair of the map.
+func (m *LinkedMap[K, V]) Front() (key K, value V, ok bool) {
+	if m.head != nil {
+		return m.head.key, m.head.value, true
+	}
+	return
+}
+
+// Back returns the last key-value pair of the map.
+func (m *LinkedMap[K, V]) Back() (key K, value V, ok bool) {
+	if m.tail != nil {
+		return m.tail.key, m.tail.value, true
+	}
+	return
+}
+
+// Walk calls the function for each key-value pair in order.
+// The walk stops when the function returns false.
+func (m *LinkedMap[K, V]) Walk(fn functions.BiPredicate[K, V]) {
+	for e := m.head; e != nil && fn(e.key, e.value); e = e.next {
+	}
+}
+
+// Iterator the variadic parameter is just a trick to allow to use the iterator without requiring parameters.
+// its presence indicates the keys must be sorted, otherwise they follow the order of the map.
+func (m *LinkedMap[K, V]) Iterator(comparator ...comparables.FunctionalComparator[K]) iterables.MapIterator[K, V] {
+	keys := m.Keys()
+
+	if len(comparator) > 0 {
+		sortables.Sort[K](&keys, sorts.NewQuicksort[K](comparator[0]))
+	}
+
+	return &LinkedMapIterator[K, V]{
+		m:    m,
+		keys: keys,
+	}
+}
+
+// All returns a sequence of the key-value pairs of the map in order.
+func (m *LinkedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := m.head; e != nil; e = e.next {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns a sequence of the keys of the map in order.
+func (m *LinkedMap[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for e := m.head; e != nil; e = e.next {
+			if !yield(e.key) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns a sequence of the values of the map in order.
+func (m *LinkedMap[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for e := m.head; e != nil; e = e.next {
+			if !yield(e.value) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalJSON encodes the map as a JSON object whose members follow the order of the keys.
+// Keys are encoded like encoding/json does for map keys: strings are used as they are,
+// encoding.TextMarshaler implementations are marshalled, and integers are formatted.
+func (m *LinkedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for e := m.head; e != nil; e = e.next {
+		if e != m.head {
+			buf.WriteByte(',')
+		}
+		key, err := encodeKey(e.key)
+		if err != nil {
+			return nil, err
+		}
+		k, _ := json.Marshal(key)
+		buf.Write(k)
+		buf.WriteByte(':')
+
+		v, err := json.Marshal(e.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object into the map, putting its members in the order they appear.
+// Like encoding/json does with maps, the existing entries are kept.
+func (m *LinkedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	// A null leaves the map untouched
+	if t == nil {
+		return nil
+	}
+	if d, ok := t.(json.Delim); !ok || d != '{' {
+		return fmt.Errorf("cannot unmarshal %v into a LinkedMap", t)
+	}
+
+	for dec.More() {
+		t, err = dec.Token()
+		if err != nil {
+			return err
+		}
+		key, err := decodeKey[K](t.(string))
+		if err != nil {
+			return err
+		}
+		var value V
+		if err = dec.Decode(&value); err != nil {
+			return err
+		}
+		m.Put(key, value)
+	}
+
+	// Consume the closing delimiter
+	_, err = dec.Token()
+	return err
+}
+
+func (m *LinkedMap[K, V]) String() string {
+	var sb strings.Builder
+	for e := m.head; e != nil; e = e.next {
+		sb.WriteString(fmt.Sprintf("%v: %v\n", e.key, e.value))
+	}
+	return sb.String()
+}
+
+var _ StructMap[string, string] = (*LinkedMap[string, string])(nil)
+
+func (m *LinkedMap[K, V]) pushBack(e *linkedEntry[K, V]) {
+	e.prev, e.next = m.tail, nil
+	if m.tail != nil {
+		m.tail.next = e
+	} else {
+		m.head = e
+	}
+	m.tail = e
+}
+
+func (m *LinkedMap[K, V]) pushFront(e *linkedEntry[K, V]) {
+	e.prev, e.next = nil, m.head
+	if m.head != nil {
+		m.head.prev = e
+	} else {
+		m.tail = e
+	}
+	m.head = e
+}
+
+func (m *LinkedMap[K, V]) unlink(e *linkedEntry[K, V]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		m.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		m.tail = e.prev
+	}
+	e.prev, e.next = nil, nil
+}
+
+// encodeKey converts a key into a JSON object member name.
+func encodeKey[K comparable](key K) (string, error) {
+	v := reflect.ValueOf(key)
+	if v.Kind() == reflect.String {
+		return v.String(), nil
+	}
+	if tm, ok := any(key).(encoding.TextMarshaler); ok {
+		b, err := tm.MarshalText()
+		return string(b), err
+	}
+
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("unsupported key type: %T", key)
+}
+
+// decodeKey converts a JSON object member name into a key.
+func decodeKey[K comparable](s string) (K, error) {
+	var key K
+	if tu, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(s))
+		return key, err
+	}
+
+	v := reflect.ValueOf(&key).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+		return key, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return key, err
+		}
+		v.SetInt(n)
+		return key, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return key, err
+		}
+		v.SetUint(n)
+		return key, nil
+	}
+	return key, fmt.Errorf("unsupported key type: %T", key)
+}
+
+type LinkedMapIterator[K comparable, V any] struct {
+	m       *LinkedMap[K, V]
+	keys    []K
+	current int
+}
+
+func (it *LinkedMapIterator[K, V]) Next() (key K, value V, ok bool) {
+	// Skip the keys removed after the iterator was created
+	for it.current < len(it.keys) {
+		key = it.keys[it.current]
+		it.current++
+		if e, exists := it.m.index[key]; exists {
+			return key, e.value, true
+		}
+	}
+	var zero K
+	return zero, value, false
+}
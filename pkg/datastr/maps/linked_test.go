@@ -0,0 +1,122 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package maps
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestLinkedMap_Order(t *testing.T) {
+	m := Linked[string, int]()
+	for i, k := range []string{"zeta", "alpha", "mid"} {
+		m.Put(k, i)
+	}
+	// Replacing a value keeps the position of the key
+	m.Put("zeta", 10)
+
+	if !reflect.DeepEqual(m.Keys(), []string{"zeta", "alpha", "mid"}) {
+		t.Errorf("Keys() = %v, want [zeta alpha mid]", m.Keys())
+	}
+	if !reflect.DeepEqual(m.Values(), []int{10, 1, 2}) {
+		t.Errorf("Values() = %v, want [10 1 2]", m.Values())
+	}
+
+	m.MoveToFront("mid")
+	m.MoveToBack("zeta")
+	if !reflect.DeepEqual(m.Keys(), []string{"mid", "alpha", "zeta"}) {
+		t.Errorf("Keys() = %v after the moves, want [mid alpha zeta]", m.Keys())
+	}
+	if m.MoveToFront("missing") {
+		t.Errorf("MoveToFront() of a missing key returned true")
+	}
+
+	m.Delete("alpha")
+	if k, _, _ := m.Front(); k != "mid" {
+		t.Errorf("Front() = %v, want mid", k)
+	}
+	if k, _, _ := m.Back(); k != "zeta" {
+		t.Errorf("Back() = %v, want zeta", k)
+	}
+
+	var keys []string
+	it := m.Iterator()
+	for k, _, ok := it.Next(); ok; k, _, ok = it.Next() {
+		keys = append(keys, k)
+	}
+	if !reflect.DeepEqual(keys, []string{"mid", "zeta"}) {
+		t.Errorf("Iterator() keys = %v, want [mid zeta]", keys)
+	}
+}
+
+func TestLinkedMap_JSON(t *testing.T) {
+	m := Linked[string, any]()
+	m.Put("version", 2)
+	m.Put("name", "api")
+	m.Put("enabled", true)
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatalf("Marshal() returned an error: %v", err)
+	}
+	if want := `{"version":2,"name":"api","enabled":true}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var loaded LinkedMap[string, json.RawMessage]
+	if err = json.Unmarshal([]byte(`{"b": 1, "a": {"x": [1, 2]}, "c": null}`), &loaded); err != nil {
+		t.Fatalf("Unmarshal() returned an error: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Keys(), []string{"b", "a", "c"}) {
+		t.Errorf("Unmarshal() keys = %v, want [b a c]", loaded.Keys())
+	}
+}
+
+func TestLinkedMap_JSONIntegerKeys(t *testing.T) {
+	m := Linked[int, string]()
+	m.Put(3, "c")
+	m.Put(1, "a")
+
+	data, _ := json.Marshal(m)
+	if string(data) != `{"3":"c","1":"a"}` {
+		t.Errorf("Marshal() = %s", data)
+	}
+
+	loaded := Linked[int, string]()
+	if err := json.Unmarshal(data, loaded); err != nil {
+		t.Fatalf("Unmarshal() returned an error: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Keys(), []int{3, 1}) {
+		t.Errorf("Unmarshal() keys = %v, want [3 1]", loaded.Keys())
+	}
+	if err := json.Unmarshal([]byte(`{"x":"a"}`), loaded); err == nil {
+		t.Errorf("Unmarshal() accepted a non integer key")
+	}
+}
+
+func TestLinkedMap_All(t *testing.T) {
+	m := Linked[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	var keys []string
+	for k, v := range m.All() {
+		if v == 2 {
+			break
+		}
+		keys = append(keys, k)
+	}
+	if !reflect.DeepEqual(keys, []string{"c", "a"}) {
+		t.Errorf("All() keys = %v, want [c a]", keys)
+	}
+
+	sum := 0
+	for v := range m.ValuesSeq() {
+		sum += v
+	}
+	if sum != 6 {
+		t.Errorf("ValuesSeq() summed %d, want 6", sum)
+	}
+}
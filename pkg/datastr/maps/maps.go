@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/andrerrcosta2/gtools/pkg/functions"
 	"github.com/andrerrcosta2/gtools/pkg/generics"
+	"iter"
 	"sort"
 )
 
@@ -200,6 +201,18 @@ func (e *EntrySet[K, V]) Len() int {
 	return len(e.entries)
 }
 
+// All returns a sequence of the keys and values in the EntrySet.
+// The entries of the EntrySet aren't guaranteed to be in any particular order.
+func (e *EntrySet[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, entry := range e.entries {
+			if !yield(entry.Key(), entry.Value()) {
+				return
+			}
+		}
+	}
+}
+
 // Each applies the given BiConsumer function to each key-value pair in the map.
 //
 // Parameters:
@@ -238,6 +251,36 @@ func Map[K comparable, V any, L comparable, X any](m *map[K]V, f functions.BiFun
 	return &result
 }
 
+// MapErr applies a given fallible BiFunction to each key-value pair in a map and returns a new map.
+// It stops at the first error.
+//
+// Parameters:
+// - m: The input map.
+// - f: The BiFunction to apply to each key-value pair. It should return a pointer to an Entry struct or an error.
+//
+// Returns:
+// - A new map with the entries obtained by applying the BiFunction to each key-value pair, or nil.
+// - The first error, wrapped with the key of the failing pair, or nil.
+func MapErr[K comparable, V any, L comparable, X any](m *map[K]V, f functions.BiFunction2[K, V, *ComparableEntry[L, X], error]) (*map[L]X, error) {
+	// Create a new map with initial capacity equal to the number of entries in the input map.
+	result := make(map[L]X, len(*m))
+
+	// Iterate over each key-value pair in the input map.
+	for k, v := range *m {
+		// Apply the BiFunction to the current key-value pair and obtain an Entry pointer.
+		entry, err := f(k, v)
+		if err != nil {
+			return nil, fmt.Errorf("key %v: %w", k, err)
+		}
+
+		// Add the key-value pair from the Entry to the result map.
+		result[entry.Key()] = entry.Value()
+	}
+
+	// Return the resulting map.
+	return &result, nil
+}
+
 // MapEntries applies a given BiFunction to each key-value pair in a map and returns a new EntrySet.
 //
 // Parameters:
@@ -3,6 +3,7 @@
 package maps
 
 import (
+	"errors"
 	"fmt"
 	"github.com/andrerrcosta2/gtools/pkg/arrays"
 	"github.com/andrerrcosta2/gtools/pkg/functions"
@@ -157,6 +158,26 @@ func TestMap(t *testing.T) {
 	}
 }
 
+func TestMapErr(t *testing.T) {
+	input := map[string]string{"a": "1", "b": "x"}
+	parse := func(k string, v string) (*ComparableEntry[string, int], error) {
+		if v == "x" {
+			return nil, errors.New("not a number")
+		}
+		return NewComparableEntry(k, 1), nil
+	}
+
+	result, err := MapErr(&input, parse)
+	if result != nil || err == nil || !strings.Contains(err.Error(), "key b") {
+		t.Errorf("MapErr() = %v, %v, want an error for the key b", result, err)
+	}
+
+	delete(input, "b")
+	if result, err = MapErr(&input, parse); err != nil || (*result)["a"] != 1 {
+		t.Errorf("MapErr() = %v, %v, want map[a:1]", result, err)
+	}
+}
+
 func TestMapEntries(t *testing.T) {
 	m := map[string]int{"a": 1, "b": 2, "c": 3}
 	e1 := MapEntries(m, func(k string, v int) *ComparableEntry[string, int] {
@@ -0,0 +1,268 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package maps
+
+import (
+	"fmt"
+	"iter"
+	"strings"
+)
+
+// ListMultiMap creates a new MultiMap whose buckets are lists.
+// A key may hold the same value several times, and values keep their insertion order.
+func ListMultiMap[K comparable, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{
+		data: make(map[K]*bucket[V]),
+	}
+}
+
+// SetMultiMap creates a new MultiMap whose buckets are sets.
+// Adding a value already held by a key is a no-op, and values keep their insertion order.
+func SetMultiMap[K comparable, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{
+		data:   make(map[K]*bucket[V]),
+		unique: true,
+	}
+}
+
+// MultiMap is a map where each key holds a collection of values.
+// Keys without values are removed, so a key is present only while it holds at least one value.
+// This isn't Thread-Safe.
+type MultiMap[K comparable, V comparable] struct {
+	data map[K]*bucket[V]
+	// unique indicates the buckets are sets instead of lists
+	unique bool
+	// size is the number of key-value pairs
+	size int
+}
+
+// bucket holds the values of a key in insertion order.
+// The index is only maintained for set buckets, where it provides O(1) membership checks.
+type bucket[V comparable] struct {
+	values []V
+	index  map[V]struct{}
+}
+
+// Put adds the values to the key.
+// On set buckets, the values already held by the key are ignored.
+func (m *MultiMap[K, V]) Put(key K, values ...V) {
+	b, ok := m.data[key]
+	if !ok {
+		if len(values) == 0 {
+			return
+		}
+		b = &bucket[V]{}
+		if m.unique {
+			b.index = make(map[V]struct{})
+		}
+		m.data[key] = b
+	}
+
+	for _, v := range values {
+		if m.unique {
+			if _, exists := b.index[v]; exists {
+				continue
+			}
+			b.index[v] = struct{}{}
+		}
+		b.values = append(b.values, v)
+		m.size++
+	}
+}
+
+// Get returns a copy of the values held by the key, or nil if the key doesn't exist.
+func (m *MultiMap[K, V]) Get(key K) []V {
+	b, ok := m.data[key]
+	if !ok {
+		return nil
+	}
+	out := make([]V, len(b.values))
+	copy(out, b.values)
+	return out
+}
+
+// Contains checks if the key holds any value.
+func (m *MultiMap[K, V]) Contains(key K) bool {
+	_, ok := m.data[key]
+	return ok
+}
+
+// ContainsEntry checks if the key holds the value.
+func (m *MultiMap[K, V]) ContainsEntry(key K, value V) bool {
+	b, ok := m.data[key]
+	if !ok {
+		return false
+	}
+	return b.has(value)
+}
+
+// ContainsValue checks if any key holds the value.
+// It has a complexity of O(n) where n is the number of key-value pairs.
+func (m *MultiMap[K, V]) ContainsValue(value V) bool {
+	for _, b := range m.data {
+		if b.has(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remove deletes the key and returns the values it held.
+func (m *MultiMap[K, V]) Remove(key K) []V {
+	b, ok := m.data[key]
+	if !ok {
+		return nil
+	}
+	delete(m.data, key)
+	m.size -= len(b.values)
+	return b.values
+}
+
+// RemoveValue deletes one occurrence of the value from the key.
+// The key is removed when its last value is deleted.
+//
+// Returns:
+// - bool: True if the value was found and removed, false otherwise.
+func (m *MultiMap[K, V]) RemoveValue(key K, value V) bool {
+	b, ok := m.data[key]
+	if !ok || !b.has(value) {
+		return false
+	}
+
+	for i, v := range b.values {
+		if v == value {
+			b.values = append(b.values[:i], b.values[i+1:]...)
+			break
+		}
+	}
+	if m.unique {
+		delete(b.index, value)
+	}
+	m.size--
+
+	// Keys without values aren't kept
+	if len(b.values) == 0 {
+		delete(m.data, key)
+	}
+	return true
+}
+
+// Len returns the number of key-value pairs.
+func (m *MultiMap[K, V]) Len() int {
+	return m.size
+}
+
+// KeyLen returns the number of distinct keys.
+func (m *MultiMap[K, V]) KeyLen() int {
+	return len(m.data)
+}
+
+// Clear removes every key.
+func (m *MultiMap[K, V]) Clear() {
+	m.data = make(map[K]*bucket[V])
+	m.size = 0
+}
+
+// Keys returns the distinct keys.
+// The keys aren't guaranteed to be in any particular order.
+func (m *MultiMap[K, V]) Keys() []K {
+	out := make([]K, 0, len(m.data))
+	for k := range m.data {
+		out = append(out, k)
+	}
+	return out
+}
+
+// Values returns the values of every key.
+// The values of the same key keep their insertion order, but the keys aren't in any particular order.
+func (m *MultiMap[K, V]) Values() []V {
+	out := make([]V, 0, m.size)
+	for _, b := range m.data {
+		out = append(out, b.values...)
+	}
+	return out
+}
+
+// Entries returns every key-value pair as a separate entry.
+// The entries of the same key keep their insertion order, but the keys aren't in any particular order.
+func (m *MultiMap[K, V]) Entries() []*ComparableEntry[K, V] {
+	out := make([]*ComparableEntry[K, V], 0, m.size)
+	for k, b := range m.data {
+		for _, v := range b.values {
+			out = append(out, NewComparableEntry(k, v))
+		}
+	}
+	return out
+}
+
+// Inverse returns a new MultiMap where each value maps to the keys holding it.
+// The bucket kind is preserved, and further changes to either map don't affect the other.
+func (m *MultiMap[K, V]) Inverse() *MultiMap[V, K] {
+	inv := &MultiMap[V, K]{
+		data:   make(map[V]*bucket[K]),
+		unique: m.unique,
+	}
+	for k, b := range m.data {
+		for _, v := range b.values {
+			inv.Put(v, k)
+		}
+	}
+	return inv
+}
+
+// All returns a sequence of every key-value pair, a key being yielded once for each of its values.
+// The values of the same key keep their insertion order, but the keys aren't in any particular order.
+func (m *MultiMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, b := range m.data {
+			for _, v := range b.values {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Buckets returns a sequence of the distinct keys and copies of their values, in no particular order.
+func (m *MultiMap[K, V]) Buckets() iter.Seq2[K, []V] {
+	return func(yield func(K, []V) bool) {
+		for k := range m.data {
+			if !yield(k, m.Get(k)) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns a sequence of the distinct keys, in no particular order.
+func (m *MultiMap[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.data {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func (m *MultiMap[K, V]) String() string {
+	var sb strings.Builder
+	for k, b := range m.data {
+		sb.WriteString(fmt.Sprintf("%v: %v\n", k, b.values))
+	}
+	return sb.String()
+}
+
+func (b *bucket[V]) has(value V) bool {
+	if b.index != nil {
+		_, ok := b.index[value]
+		return ok
+	}
+	for _, v := range b.values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
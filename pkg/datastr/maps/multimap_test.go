@@ -0,0 +1,87 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package maps
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMultiMap_ListBuckets(t *testing.T) {
+	m := ListMultiMap[string, int]()
+	m.Put("a", 1, 2, 1)
+	m.Put("b", 3)
+
+	if got := m.Get("a"); !reflect.DeepEqual(got, []int{1, 2, 1}) {
+		t.Errorf("Get(a) = %v, want [1 2 1]", got)
+	}
+	if m.Len() != 4 || m.KeyLen() != 2 {
+		t.Errorf("Len() = %d, KeyLen() = %d, want 4, 2", m.Len(), m.KeyLen())
+	}
+
+	// Only one occurrence is removed
+	if !m.RemoveValue("a", 1) {
+		t.Fatalf("RemoveValue(a, 1) = false")
+	}
+	if got := m.Get("a"); !reflect.DeepEqual(got, []int{2, 1}) {
+		t.Errorf("Get(a) = %v after RemoveValue, want [2 1]", got)
+	}
+	if m.RemoveValue("a", 5) || m.RemoveValue("c", 1) {
+		t.Errorf("RemoveValue() of a missing entry returned true")
+	}
+}
+
+func TestMultiMap_SetBuckets(t *testing.T) {
+	m := SetMultiMap[string, string]()
+	m.Put("admins", "alice", "bob", "alice")
+	m.Put("admins", "bob", "carol")
+
+	if got := m.Get("admins"); !reflect.DeepEqual(got, []string{"alice", "bob", "carol"}) {
+		t.Errorf("Get(admins) = %v, want [alice bob carol]", got)
+	}
+	if m.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", m.Len())
+	}
+	if !m.ContainsEntry("admins", "bob") || m.ContainsEntry("admins", "dave") {
+		t.Errorf("ContainsEntry() returned a wrong result")
+	}
+}
+
+func TestMultiMap_RemoveLastValueRemovesKey(t *testing.T) {
+	m := SetMultiMap[int, int]()
+	m.Put(1, 10)
+	m.RemoveValue(1, 10)
+
+	if m.Contains(1) || m.KeyLen() != 0 || m.Len() != 0 {
+		t.Errorf("the key wasn't removed with its last value")
+	}
+
+	m.Put(2, 20, 21)
+	if got := m.Remove(2); len(got) != 2 || m.Len() != 0 {
+		t.Errorf("Remove(2) = %v, Len() = %d", got, m.Len())
+	}
+}
+
+func TestMultiMap_EntriesAndInverse(t *testing.T) {
+	m := ListMultiMap[string, string]()
+	m.Put("read", "alice", "bob")
+	m.Put("write", "alice")
+
+	if len(m.Entries()) != 3 {
+		t.Errorf("Entries() returned %d entries, want 3", len(m.Entries()))
+	}
+
+	inv := m.Inverse()
+	roles := inv.Get("alice")
+	sort.Strings(roles)
+	if !reflect.DeepEqual(roles, []string{"read", "write"}) {
+		t.Errorf("Inverse().Get(alice) = %v, want [read write]", roles)
+	}
+	if !reflect.DeepEqual(inv.Get("bob"), []string{"read"}) {
+		t.Errorf("Inverse().Get(bob) = %v, want [read]", inv.Get("bob"))
+	}
+	if !m.ContainsValue("bob") || m.ContainsValue("carol") {
+		t.Errorf("ContainsValue() returned a wrong result")
+	}
+}
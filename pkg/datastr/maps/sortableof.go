@@ -9,6 +9,7 @@ import (
 	"github.com/andrerrcosta2/gtools/pkg/gtools"
 	"github.com/andrerrcosta2/gtools/pkg/sortables"
 	"github.com/andrerrcosta2/gtools/pkg/sorts"
+	"iter"
 	"sort"
 	"strings"
 )
@@ -98,6 +99,39 @@ func (m *SortableOfMap[K, V]) Iterator(comparator ...comparables.FunctionalCompa
 	}
 }
 
+// All returns a sequence of the key-value pairs of the map, in no particular order.
+func (m *SortableOfMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, entry := range m.data {
+			if !yield(entry.Key(), entry.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// KeysSeq returns a sequence of the keys of the map, in no particular order.
+func (m *SortableOfMap[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, entry := range m.data {
+			if !yield(entry.Key()) {
+				return
+			}
+		}
+	}
+}
+
+// ValuesSeq returns a sequence of the values of the map, in no particular order.
+func (m *SortableOfMap[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, entry := range m.data {
+			if !yield(entry.Value()) {
+				return
+			}
+		}
+	}
+}
+
 var _ StructMap[gtools.SortableOf, string] = (*SortableOfMap[gtools.SortableOf, string])(nil)
 
 type SortableOfMapIterator[K gtools.SortableOf, V any] struct {
@@ -0,0 +1,194 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package probabilistic
+
+import (
+	"encoding"
+	"encoding/binary"
+	"math"
+	"math/bits"
+)
+
+// Bloom creates a new BloomFilter sized to hold the expected number of elements
+// while keeping the false-positive rate at most p.
+//
+// The optimal dimensions are:
+//
+//	m = -n * ln(p) / ln(2)^2 bits
+//	k = m / n * ln(2) hash functions
+//
+// Parameters:
+// - n: The expected number of elements.
+// - p: The desired false-positive rate, in the range (0, 1).
+//
+// Returns:
+// - A pointer to the new BloomFilter.
+func Bloom(n uint, p float64) *BloomFilter {
+	m, k := optimalDimensions(n, p)
+	return BloomOfSize(m, k)
+}
+
+// BloomOfSize creates a new BloomFilter with m bits and k hash functions.
+// Both dimensions are at least 1, and k is at most 64.
+func BloomOfSize(m, k uint) *BloomFilter {
+	m, k = max(m, 1), min(max(k, 1), maxHashes)
+	return &BloomFilter{
+		m:    uint64(m),
+		k:    uint64(k),
+		bits: make([]uint64, (m+63)/64),
+	}
+}
+
+// BloomFilter is a probabilistic set that can answer "definitely not present" or "probably present".
+// It never yields false negatives, and its false-positive rate grows with the number of elements.
+type BloomFilter struct {
+	m    uint64
+	k    uint64
+	n    uint64
+	bits []uint64
+}
+
+// Add inserts the data into the filter.
+func (f *BloomFilter) Add(data []byte) {
+	h1, h2 := hashes(data)
+	for i := uint64(0); i < f.k; i++ {
+		l := location(h1, h2, i, f.m)
+		f.bits[l/64] |= 1 << (l % 64)
+	}
+	f.n++
+}
+
+// AddString inserts the string into the filter.
+func (f *BloomFilter) AddString(s string) {
+	f.Add([]byte(s))
+}
+
+// Test checks if the data may have been added to the filter.
+// A false result is always accurate, a true result may be a false positive.
+func (f *BloomFilter) Test(data []byte) bool {
+	h1, h2 := hashes(data)
+	for i := uint64(0); i < f.k; i++ {
+		l := location(h1, h2, i, f.m)
+		if f.bits[l/64]&(1<<(l%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// TestString checks if the string may have been added to the filter.
+func (f *BloomFilter) TestString(s string) bool {
+	return f.Test([]byte(s))
+}
+
+// TestAndAdd checks if the data may have been added to the filter, then adds it.
+// This is the usual operation when deduplicating a stream.
+func (f *BloomFilter) TestAndAdd(data []byte) bool {
+	present := f.Test(data)
+	f.Add(data)
+	return present
+}
+
+// Cap returns the number of bits of the filter.
+func (f *BloomFilter) Cap() uint {
+	return uint(f.m)
+}
+
+// Hashes returns the number of hash functions of the filter.
+func (f *BloomFilter) Hashes() uint {
+	return uint(f.k)
+}
+
+// Count returns the number of insertions made into the filter, duplicates included.
+func (f *BloomFilter) Count() uint {
+	return uint(f.n)
+}
+
+// FalsePositiveRate estimates the current false-positive rate from the fraction of bits set.
+//
+//	p = (bits set / m)^k
+func (f *BloomFilter) FalsePositiveRate() float64 {
+	set := 0
+	for _, w := range f.bits {
+		set += bits.OnesCount64(w)
+	}
+	return math.Pow(float64(set)/float64(f.m), float64(f.k))
+}
+
+// Merge adds every element of the other filter into this one.
+// Both filters must have the same dimensions.
+func (f *BloomFilter) Merge(o *BloomFilter) error {
+	if f.m != o.m || f.k != o.k {
+		return ErrIncompatible
+	}
+	for i := range f.bits {
+		f.bits[i] |= o.bits[i]
+	}
+	f.n += o.n
+	return nil
+}
+
+// Clear removes every element from the filter.
+func (f *BloomFilter) Clear() {
+	clear(f.bits)
+	f.n = 0
+}
+
+// MarshalBinary encodes the filter, so it can be stored and loaded later with UnmarshalBinary.
+func (f *BloomFilter) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, headerSize+24+len(f.bits)*8)
+	buf = writeHeader(buf, bloomMagic)
+	buf = binary.BigEndian.AppendUint64(buf, f.m)
+	buf = binary.BigEndian.AppendUint64(buf, f.k)
+	buf = binary.BigEndian.AppendUint64(buf, f.n)
+	for _, w := range f.bits {
+		buf = binary.BigEndian.AppendUint64(buf, w)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a filter encoded by MarshalBinary, replacing the current contents.
+func (f *BloomFilter) UnmarshalBinary(data []byte) error {
+	data, err := readHeader(data, bloomMagic)
+	if err != nil {
+		return err
+	}
+	if len(data) < 24 {
+		return ErrCorrupted
+	}
+
+	m := binary.BigEndian.Uint64(data[0:])
+	k := binary.BigEndian.Uint64(data[8:])
+	n := binary.BigEndian.Uint64(data[16:])
+	data = data[24:]
+
+	words := m / 64
+	if m%64 != 0 {
+		words++
+	}
+	if m == 0 || k == 0 || k > maxHashes || !fits(data, words, 8) {
+		return ErrCorrupted
+	}
+
+	f.m, f.k, f.n = m, k, n
+	f.bits = make([]uint64, words)
+	for i := range f.bits {
+		f.bits[i] = binary.BigEndian.Uint64(data[i*8:])
+	}
+	return nil
+}
+
+var _ encoding.BinaryMarshaler = (*BloomFilter)(nil)
+var _ encoding.BinaryUnmarshaler = (*BloomFilter)(nil)
+
+// optimalDimensions computes the number of bits and hash functions of a filter
+// holding n elements with a false-positive rate of p.
+func optimalDimensions(n uint, p float64) (uint, uint) {
+	n = max(n, 1)
+	// Clamp the rate to a meaningful range
+	p = math.Min(math.Max(p, math.SmallestNonzeroFloat64), 0.999)
+
+	m := math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2))
+	k := math.Round(m / float64(n) * math.Ln2)
+	return uint(max(m, 1)), uint(max(k, 1))
+}
@@ -0,0 +1,140 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package probabilistic
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/fsutil"
+	"github.com/andrerrcosta2/gtools/pkg/osutil"
+	"math"
+	"path/filepath"
+	"testing"
+)
+
+func TestBloomFilter_NoFalseNegatives(t *testing.T) {
+	f := Bloom(1000, 0.01)
+
+	for i := 0; i < 1000; i++ {
+		f.AddString(fmt.Sprintf("event-%d", i))
+	}
+
+	for i := 0; i < 1000; i++ {
+		if !f.TestString(fmt.Sprintf("event-%d", i)) {
+			t.Fatalf("TestString(event-%d) = false for an added element", i)
+		}
+	}
+
+	if f.Count() != 1000 {
+		t.Errorf("Count() = %d, want 1000", f.Count())
+	}
+}
+
+func TestBloomFilter_FalsePositiveRate(t *testing.T) {
+	f := Bloom(10000, 0.01)
+
+	for i := 0; i < 10000; i++ {
+		f.AddString(fmt.Sprintf("in-%d", i))
+	}
+
+	positives := 0
+	for i := 0; i < 10000; i++ {
+		if f.TestString(fmt.Sprintf("out-%d", i)) {
+			positives++
+		}
+	}
+
+	// Leave room for the statistical variance around the 1% target
+	if rate := float64(positives) / 10000; rate > 0.02 {
+		t.Errorf("false-positive rate = %.4f, want at most 0.02", rate)
+	}
+	if rate := f.FalsePositiveRate(); rate > 0.02 {
+		t.Errorf("FalsePositiveRate() = %.4f, want at most 0.02", rate)
+	}
+}
+
+func TestBloomFilter_Merge(t *testing.T) {
+	a, b := BloomOfSize(1024, 4), BloomOfSize(1024, 4)
+	a.AddString("a")
+	b.AddString("b")
+
+	if err := a.Merge(b); err != nil {
+		t.Fatalf("Merge() returned an error: %v", err)
+	}
+	if !a.TestString("a") || !a.TestString("b") {
+		t.Errorf("Merge() lost elements")
+	}
+
+	if err := a.Merge(BloomOfSize(512, 4)); !errors.Is(err, ErrIncompatible) {
+		t.Errorf("Merge() = %v, want ErrIncompatible", err)
+	}
+}
+
+func TestBloomFilter_SaveAndLoad(t *testing.T) {
+	f := Bloom(100, 0.001)
+	f.AddString("persisted")
+
+	data, err := f.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() returned an error: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "events.bloom")
+	if err := osutil.WriteFile(fsutil.Literal, path, data, 0o644); err != nil {
+		t.Fatalf("WriteFile() returned an error: %v", err)
+	}
+	read, err := osutil.ReadFile(fsutil.Literal, path)
+	if err != nil {
+		t.Fatalf("ReadFile() returned an error: %v", err)
+	}
+
+	var loaded BloomFilter
+	if err := loaded.UnmarshalBinary(read); err != nil {
+		t.Fatalf("UnmarshalBinary() returned an error: %v", err)
+	}
+	if !loaded.TestString("persisted") || loaded.Cap() != f.Cap() || loaded.Hashes() != f.Hashes() || loaded.Count() != 1 {
+		t.Errorf("the loaded filter differs from the saved one")
+	}
+
+	if err := loaded.UnmarshalBinary(read[:len(read)-1]); !errors.Is(err, ErrCorrupted) {
+		t.Errorf("UnmarshalBinary() of truncated data = %v, want ErrCorrupted", err)
+	}
+	if err := loaded.UnmarshalBinary([]byte("GTCB\x01")); !errors.Is(err, ErrCorrupted) {
+		t.Errorf("UnmarshalBinary() of another structure = %v, want ErrCorrupted", err)
+	}
+}
+
+// serialized builds serialized data with the given dimensions followed by size zero bytes.
+func serialized(magic string, a, b, c uint64, size int) []byte {
+	data := writeHeader(nil, magic)
+	data = binary.BigEndian.AppendUint64(data, a)
+	data = binary.BigEndian.AppendUint64(data, b)
+	data = binary.BigEndian.AppendUint64(data, c)
+	return append(data, make([]byte, size)...)
+}
+
+func TestBloomFilter_UnmarshalCorruptedHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"no bits", serialized(bloomMagic, 0, 3, 0, 0)},
+		{"no hash functions", serialized(bloomMagic, 64, 0, 0, 8)},
+		{"too many hash functions", serialized(bloomMagic, 64, 1<<40, 0, 8)},
+		{"overflowing size", serialized(bloomMagic, math.MaxUint64, 3, 0, 0)},
+		{"short data", serialized(bloomMagic, 128, 3, 0, 8)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var f BloomFilter
+			if err := f.UnmarshalBinary(tt.data); !errors.Is(err, ErrCorrupted) {
+				t.Errorf("UnmarshalBinary() = %v, want ErrCorrupted", err)
+			}
+		})
+	}
+
+	if k := BloomOfSize(64, 1000).Hashes(); k != maxHashes {
+		t.Errorf("BloomOfSize() kept %d hash functions, want %d", k, maxHashes)
+	}
+}
@@ -0,0 +1,164 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package probabilistic
+
+import (
+	"encoding"
+	"encoding/binary"
+	"math"
+)
+
+// Counting creates a new CountingFilter sized to hold the expected number of elements
+// while keeping the false-positive rate at most p.
+//
+// Parameters:
+// - n: The expected number of elements.
+// - p: The desired false-positive rate, in the range (0, 1).
+//
+// Returns:
+// - A pointer to the new CountingFilter.
+func Counting(n uint, p float64) *CountingFilter {
+	m, k := optimalDimensions(n, p)
+	return CountingOfSize(m, k)
+}
+
+// CountingOfSize creates a new CountingFilter with m counters and k hash functions.
+// Both dimensions are at least 1, and k is at most 64.
+func CountingOfSize(m, k uint) *CountingFilter {
+	m, k = max(m, 1), min(max(k, 1), maxHashes)
+	return &CountingFilter{
+		m:        uint64(m),
+		k:        uint64(k),
+		counters: make([]uint8, m),
+	}
+}
+
+// CountingFilter is a Bloom filter whose bits are replaced by small counters, which allows removals.
+// It uses eight times the memory of a BloomFilter with the same dimensions.
+//
+// Counters saturate at 255 and are never decremented afterward, since their real value is unknown.
+// Removing an element that was never added may introduce false negatives for other elements.
+type CountingFilter struct {
+	m        uint64
+	k        uint64
+	n        uint64
+	counters []uint8
+}
+
+// Add inserts the data into the filter.
+func (f *CountingFilter) Add(data []byte) {
+	h1, h2 := hashes(data)
+	for i := uint64(0); i < f.k; i++ {
+		l := location(h1, h2, i, f.m)
+		if f.counters[l] < math.MaxUint8 {
+			f.counters[l]++
+		}
+	}
+	f.n++
+}
+
+// AddString inserts the string into the filter.
+func (f *CountingFilter) AddString(s string) {
+	f.Add([]byte(s))
+}
+
+// Remove deletes one occurrence of the data from the filter.
+// It returns false, leaving the filter untouched, if the data is definitely not present.
+func (f *CountingFilter) Remove(data []byte) bool {
+	if !f.Test(data) {
+		return false
+	}
+
+	h1, h2 := hashes(data)
+	for i := uint64(0); i < f.k; i++ {
+		l := location(h1, h2, i, f.m)
+		// Saturated counters are sticky
+		if f.counters[l] < math.MaxUint8 {
+			f.counters[l]--
+		}
+	}
+	if f.n > 0 {
+		f.n--
+	}
+	return true
+}
+
+// RemoveString deletes one occurrence of the string from the filter.
+func (f *CountingFilter) RemoveString(s string) bool {
+	return f.Remove([]byte(s))
+}
+
+// Test checks if the data may be present in the filter.
+// A false result is always accurate, a true result may be a false positive.
+func (f *CountingFilter) Test(data []byte) bool {
+	h1, h2 := hashes(data)
+	for i := uint64(0); i < f.k; i++ {
+		if f.counters[location(h1, h2, i, f.m)] == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// TestString checks if the string may be present in the filter.
+func (f *CountingFilter) TestString(s string) bool {
+	return f.Test([]byte(s))
+}
+
+// Cap returns the number of counters of the filter.
+func (f *CountingFilter) Cap() uint {
+	return uint(f.m)
+}
+
+// Hashes returns the number of hash functions of the filter.
+func (f *CountingFilter) Hashes() uint {
+	return uint(f.k)
+}
+
+// Count returns the number of elements currently in the filter, duplicates included.
+func (f *CountingFilter) Count() uint {
+	return uint(f.n)
+}
+
+// Clear removes every element from the filter.
+func (f *CountingFilter) Clear() {
+	clear(f.counters)
+	f.n = 0
+}
+
+// MarshalBinary encodes the filter, so it can be stored and loaded later with UnmarshalBinary.
+func (f *CountingFilter) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, headerSize+24+len(f.counters))
+	buf = writeHeader(buf, countingMagic)
+	buf = binary.BigEndian.AppendUint64(buf, f.m)
+	buf = binary.BigEndian.AppendUint64(buf, f.k)
+	buf = binary.BigEndian.AppendUint64(buf, f.n)
+	return append(buf, f.counters...), nil
+}
+
+// UnmarshalBinary decodes a filter encoded by MarshalBinary, replacing the current contents.
+func (f *CountingFilter) UnmarshalBinary(data []byte) error {
+	data, err := readHeader(data, countingMagic)
+	if err != nil {
+		return err
+	}
+	if len(data) < 24 {
+		return ErrCorrupted
+	}
+
+	m := binary.BigEndian.Uint64(data[0:])
+	k := binary.BigEndian.Uint64(data[8:])
+	n := binary.BigEndian.Uint64(data[16:])
+	data = data[24:]
+
+	if m == 0 || k == 0 || k > maxHashes || !fits(data, m, 1) {
+		return ErrCorrupted
+	}
+
+	f.m, f.k, f.n = m, k, n
+	f.counters = append(make([]uint8, 0, m), data...)
+	return nil
+}
+
+var _ encoding.BinaryMarshaler = (*CountingFilter)(nil)
+var _ encoding.BinaryUnmarshaler = (*CountingFilter)(nil)
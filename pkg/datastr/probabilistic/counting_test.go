@@ -0,0 +1,74 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package probabilistic
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestCountingFilter_Remove(t *testing.T) {
+	f := Counting(100, 0.01)
+	f.AddString("a")
+	f.AddString("b")
+	f.AddString("b")
+
+	if !f.RemoveString("a") {
+		t.Fatalf("RemoveString(a) = false for an added element")
+	}
+	if f.TestString("a") {
+		t.Errorf("TestString(a) = true after removal")
+	}
+
+	// The second occurrence keeps the element present
+	f.RemoveString("b")
+	if !f.TestString("b") {
+		t.Errorf("TestString(b) = false with one occurrence left")
+	}
+	f.RemoveString("b")
+	if f.TestString("b") {
+		t.Errorf("TestString(b) = true after removing every occurrence")
+	}
+
+	if f.RemoveString("never") {
+		t.Errorf("RemoveString() of a missing element returned true")
+	}
+	if f.Count() != 0 {
+		t.Errorf("Count() = %d, want 0", f.Count())
+	}
+}
+
+func TestCountingFilter_MarshalBinary(t *testing.T) {
+	f := CountingOfSize(256, 3)
+	f.AddString("x")
+
+	data, _ := f.MarshalBinary()
+	loaded := &CountingFilter{}
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() returned an error: %v", err)
+	}
+	if !loaded.RemoveString("x") || loaded.TestString("x") {
+		t.Errorf("the loaded filter differs from the saved one")
+	}
+}
+
+func TestCountingFilter_UnmarshalCorruptedHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"no counters", serialized(countingMagic, 0, 3, 0, 0)},
+		{"no hash functions", serialized(countingMagic, 16, 0, 0, 16)},
+		{"too many hash functions", serialized(countingMagic, 16, math.MaxUint64, 0, 16)},
+		{"short data", serialized(countingMagic, 16, 3, 0, 15)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var f CountingFilter
+			if err := f.UnmarshalBinary(tt.data); !errors.Is(err, ErrCorrupted) {
+				t.Errorf("UnmarshalBinary() = %v, want ErrCorrupted", err)
+			}
+		})
+	}
+}
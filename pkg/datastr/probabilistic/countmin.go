@@ -0,0 +1,162 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package probabilistic
+
+import (
+	"encoding"
+	"encoding/binary"
+	"math"
+)
+
+// CountMin creates a new CountMinSketch whose estimates exceed the real frequency
+// by at most epsilon * total with probability 1 - delta.
+//
+// The dimensions are:
+//
+//	width = e / epsilon
+//	depth = ln(1 / delta)
+//
+// Parameters:
+// - epsilon: The relative error, in the range (0, 1).
+// - delta: The probability of exceeding the error, in the range (0, 1).
+//
+// Returns:
+// - A pointer to the new CountMinSketch.
+func CountMin(epsilon, delta float64) *CountMinSketch {
+	// Clamp the parameters to a meaningful range
+	epsilon = math.Min(math.Max(epsilon, 1e-9), 1)
+	delta = math.Min(math.Max(delta, 1e-9), 0.999)
+
+	w := math.Ceil(math.E / epsilon)
+	d := math.Ceil(math.Log(1 / delta))
+	return CountMinOfSize(uint(w), uint(d))
+}
+
+// CountMinOfSize creates a new CountMinSketch with the given width and depth.
+// Both dimensions are at least 1, and the depth is at most 64.
+func CountMinOfSize(width, depth uint) *CountMinSketch {
+	width, depth = max(width, 1), min(max(depth, 1), maxHashes)
+	return &CountMinSketch{
+		w:        uint64(width),
+		d:        uint64(depth),
+		counters: make([]uint64, width*depth),
+	}
+}
+
+// CountMinSketch estimates the frequency of elements in a stream using sublinear memory.
+// Estimates are never lower than the real frequency.
+type CountMinSketch struct {
+	w     uint64
+	d     uint64
+	total uint64
+	// counters holds d rows of w counters each
+	counters []uint64
+}
+
+// Add increments the frequency of the data by count.
+func (s *CountMinSketch) Add(data []byte, count uint64) {
+	h1, h2 := hashes(data)
+	for row := uint64(0); row < s.d; row++ {
+		s.counters[row*s.w+location(h1, h2, row, s.w)] += count
+	}
+	s.total += count
+}
+
+// AddString increments the frequency of the string by count.
+func (s *CountMinSketch) AddString(str string, count uint64) {
+	s.Add([]byte(str), count)
+}
+
+// Estimate returns the estimated frequency of the data.
+// It's the minimum over the counters of every row, which is the least affected by collisions.
+func (s *CountMinSketch) Estimate(data []byte) uint64 {
+	h1, h2 := hashes(data)
+	estimate := uint64(math.MaxUint64)
+	for row := uint64(0); row < s.d; row++ {
+		estimate = min(estimate, s.counters[row*s.w+location(h1, h2, row, s.w)])
+	}
+	return estimate
+}
+
+// EstimateString returns the estimated frequency of the string.
+func (s *CountMinSketch) EstimateString(str string) uint64 {
+	return s.Estimate([]byte(str))
+}
+
+// Total returns the sum of every count added to the sketch.
+func (s *CountMinSketch) Total() uint64 {
+	return s.total
+}
+
+// Width returns the number of counters per row.
+func (s *CountMinSketch) Width() uint {
+	return uint(s.w)
+}
+
+// Depth returns the number of rows, which is the number of hash functions.
+func (s *CountMinSketch) Depth() uint {
+	return uint(s.d)
+}
+
+// Merge adds the frequencies of the other sketch into this one.
+// Both sketches must have the same dimensions.
+func (s *CountMinSketch) Merge(o *CountMinSketch) error {
+	if s.w != o.w || s.d != o.d {
+		return ErrIncompatible
+	}
+	for i := range s.counters {
+		s.counters[i] += o.counters[i]
+	}
+	s.total += o.total
+	return nil
+}
+
+// Clear resets every frequency to zero.
+func (s *CountMinSketch) Clear() {
+	clear(s.counters)
+	s.total = 0
+}
+
+// MarshalBinary encodes the sketch, so it can be stored and loaded later with UnmarshalBinary.
+func (s *CountMinSketch) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, headerSize+24+len(s.counters)*8)
+	buf = writeHeader(buf, countMinMagic)
+	buf = binary.BigEndian.AppendUint64(buf, s.w)
+	buf = binary.BigEndian.AppendUint64(buf, s.d)
+	buf = binary.BigEndian.AppendUint64(buf, s.total)
+	for _, c := range s.counters {
+		buf = binary.BigEndian.AppendUint64(buf, c)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a sketch encoded by MarshalBinary, replacing the current contents.
+func (s *CountMinSketch) UnmarshalBinary(data []byte) error {
+	data, err := readHeader(data, countMinMagic)
+	if err != nil {
+		return err
+	}
+	if len(data) < 24 {
+		return ErrCorrupted
+	}
+
+	w := binary.BigEndian.Uint64(data[0:])
+	d := binary.BigEndian.Uint64(data[8:])
+	total := binary.BigEndian.Uint64(data[16:])
+	data = data[24:]
+
+	// d is bounded first, so d*8 can't overflow
+	if w == 0 || d == 0 || d > maxHashes || !fits(data, w, d*8) {
+		return ErrCorrupted
+	}
+
+	s.w, s.d, s.total = w, d, total
+	s.counters = make([]uint64, w*d)
+	for i := range s.counters {
+		s.counters[i] = binary.BigEndian.Uint64(data[i*8:])
+	}
+	return nil
+}
+
+var _ encoding.BinaryMarshaler = (*CountMinSketch)(nil)
+var _ encoding.BinaryUnmarshaler = (*CountMinSketch)(nil)
@@ -0,0 +1,93 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package probabilistic
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCountMinSketch_Estimate(t *testing.T) {
+	s := CountMin(0.001, 0.01)
+
+	// Heavy hitters on top of a long tail
+	s.AddString("login", 5000)
+	s.AddString("logout", 1200)
+	for i := 0; i < 5000; i++ {
+		s.AddString(fmt.Sprintf("page-%d", i), 1)
+	}
+
+	bound := uint64(0.001 * float64(s.Total()))
+	tests := []struct {
+		key  string
+		real uint64
+	}{
+		{"login", 5000},
+		{"logout", 1200},
+		{"page-42", 1},
+		{"missing", 0},
+	}
+
+	for _, tt := range tests {
+		got := s.EstimateString(tt.key)
+		if got < tt.real || got > tt.real+bound {
+			t.Errorf("EstimateString(%q) = %d, want between %d and %d", tt.key, got, tt.real, tt.real+bound)
+		}
+	}
+
+	if s.Total() != 11200 {
+		t.Errorf("Total() = %d, want 11200", s.Total())
+	}
+}
+
+func TestCountMinSketch_Merge(t *testing.T) {
+	a, b := CountMinOfSize(100, 4), CountMinOfSize(100, 4)
+	a.AddString("x", 3)
+	b.AddString("x", 4)
+
+	if err := a.Merge(b); err != nil {
+		t.Fatalf("Merge() returned an error: %v", err)
+	}
+	if got := a.EstimateString("x"); got != 7 {
+		t.Errorf("EstimateString(x) = %d, want 7", got)
+	}
+	if err := a.Merge(CountMinOfSize(100, 3)); !errors.Is(err, ErrIncompatible) {
+		t.Errorf("Merge() = %v, want ErrIncompatible", err)
+	}
+}
+
+func TestCountMinSketch_MarshalBinary(t *testing.T) {
+	s := CountMinOfSize(64, 3)
+	s.AddString("x", 10)
+
+	data, _ := s.MarshalBinary()
+	loaded := &CountMinSketch{}
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() returned an error: %v", err)
+	}
+	if loaded.EstimateString("x") != 10 || loaded.Total() != 10 || loaded.Width() != 64 || loaded.Depth() != 3 {
+		t.Errorf("the loaded sketch differs from the saved one")
+	}
+}
+
+func TestCountMinSketch_UnmarshalCorruptedHeader(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"no width", serialized(countMinMagic, 0, 3, 0, 0)},
+		{"no depth", serialized(countMinMagic, 4, 0, 0, 0)},
+		{"too deep", serialized(countMinMagic, 1, 1000, 0, 8000)},
+		{"overflowing size", serialized(countMinMagic, 1<<61, 1, 0, 0)},
+		{"short data", serialized(countMinMagic, 4, 3, 0, 88)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var s CountMinSketch
+			if err := s.UnmarshalBinary(tt.data); !errors.Is(err, ErrCorrupted) {
+				t.Errorf("UnmarshalBinary() = %v, want ErrCorrupted", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+// Package probabilistic provides space-efficient structures that answer membership and
+// frequency queries approximately, trading a bounded error for a fixed memory footprint.
+//
+// Hashes are derived from FNV-1a, which is stable across processes and platforms,
+// so serialized structures can be reloaded and queried by other programs.
+// These structures aren't Thread-Safe.
+package probabilistic
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+)
+
+var (
+	// ErrIncompatible is returned when merging structures with different dimensions.
+	ErrIncompatible = errors.New("probabilistic: incompatible dimensions")
+	// ErrCorrupted is returned when unmarshalling data that isn't a valid serialized structure.
+	ErrCorrupted = errors.New("probabilistic: corrupted data")
+)
+
+// Serialization headers, each structure has its own magic number
+const (
+	version       byte = 1
+	bloomMagic         = "GTBF"
+	countingMagic      = "GTCB"
+	countMinMagic      = "GTCM"
+	headerSize         = len(bloomMagic) + 1
+)
+
+// maxHashes is the maximum number of hash functions of a filter and of rows of a sketch.
+// It also bounds the work done on each operation by a structure read from untrusted data.
+const maxHashes = 64
+
+// hashes returns two independent 64-bit hashes of the data.
+// Further hashes are derived by double hashing (Kirsch-Mitzenmacher): h(i) = h1 + i*h2.
+func hashes(data []byte) (uint64, uint64) {
+	h := fnv.New128a()
+	h.Write(data)
+	sum := h.Sum(nil)
+	h1 := binary.BigEndian.Uint64(sum[:8])
+	// h2 is forced to be odd so it never collapses every index into the same position
+	h2 := binary.BigEndian.Uint64(sum[8:]) | 1
+	return h1, h2
+}
+
+// location returns the i-th derived hash of the data reduced to the range [0, m).
+func location(h1, h2 uint64, i, m uint64) uint64 {
+	return (h1 + i*h2) % m
+}
+
+// writeHeader appends the magic number and the version to the buffer.
+func writeHeader(buf []byte, magic string) []byte {
+	buf = append(buf, magic...)
+	return append(buf, version)
+}
+
+// fits checks if the data holds exactly count items of the given size, without overflowing.
+func fits(data []byte, count, size uint64) bool {
+	l := uint64(len(data))
+	return l%size == 0 && l/size == count
+}
+
+// readHeader validates the magic number and the version, returning the remaining data.
+func readHeader(data []byte, magic string) ([]byte, error) {
+	if len(data) < headerSize || string(data[:len(magic)]) != magic || data[len(magic)] != version {
+		return nil, ErrCorrupted
+	}
+	return data[headerSize:], nil
+}
@@ -2,7 +2,10 @@
 
 package sets
 
-import "maps"
+import (
+	"iter"
+	"maps"
+)
 
 // Comparable Creates a new ComparableSet from a variable number of values.
 // The set is initialized with the given values.
@@ -71,3 +74,14 @@ func (c *ComparableSet[T]) Equals(o Set[T]) bool {
 		return maps.Equal(c.set, s)
 	}
 }
+
+// All returns a sequence of the elements of the set, in no particular order.
+func (c *ComparableSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range c.set {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package sets
+
+import (
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/datastr/bitset"
+	"iter"
+)
+
+// Dense creates a new DenseSet from a variable number of values.
+//
+// A DenseSet stores small non-negative integers as bits, taking one bit per integer up to
+// the highest member instead of a map entry per member. It's the right choice for ids,
+// flags and visited markers drawn from a small range.
+// It panics if any value is negative.
+func Dense(values ...int) *DenseSet {
+	set := &DenseSet{}
+	for _, v := range values {
+		set.Add(v)
+	}
+	return set
+}
+
+// DenseSet is a set of non-negative integers backed by a bitset.Bitset.
+// Values are always kept in ascending order. The zero value is an empty set ready to use.
+// This isn't Thread-Safe.
+type DenseSet struct {
+	bits bitset.Bitset
+	// size caches the number of members, avoiding a popcount on every Len
+	size int
+}
+
+func (d *DenseSet) Has(t int) bool {
+	return t >= 0 && d.bits.Test(uint(t))
+}
+
+// Add inserts the element into the set if it doesn't already exist.
+// It panics if the element is negative.
+func (d *DenseSet) Add(t int) {
+	if t < 0 {
+		panic(fmt.Sprintf("DenseSet can't hold the negative value %d", t))
+	}
+	if !d.bits.Test(uint(t)) {
+		d.bits.Set(uint(t))
+		d.size++
+	}
+}
+
+func (d *DenseSet) Remove(t int) {
+	if d.Has(t) {
+		d.bits.Clear(uint(t))
+		d.size--
+	}
+}
+
+func (d *DenseSet) Len() int {
+	return d.size
+}
+
+// Values returns the elements in ascending order.
+func (d *DenseSet) Values() []int {
+	values := make([]int, 0, d.size)
+	for i, ok := d.bits.NextSet(0); ok; i, ok = d.bits.NextSet(i + 1) {
+		values = append(values, int(i))
+	}
+	return values
+}
+
+func (d *DenseSet) Clear() {
+	d.bits = bitset.Bitset{}
+	d.size = 0
+}
+
+func (d *DenseSet) Equals(o Set[int]) bool {
+	if d.Len() != o.Len() {
+		return false
+	}
+	switch set := o.(type) {
+	case *DenseSet:
+		return d.bits.Equal(&set.bits)
+	default:
+		for _, v := range set.Values() {
+			if !d.Has(v) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// Bits returns the underlying bitset, allowing set algebra between dense sets.
+// Changes to the bitset aren't tracked by Len, so it should be treated as read-only.
+func (d *DenseSet) Bits() *bitset.Bitset {
+	return &d.bits
+}
+
+func (d *DenseSet) Loop() <-chan int {
+	ch := make(chan int)
+	values := d.Values()
+
+	go func() {
+		defer close(ch)
+		for _, v := range values {
+			ch <- v
+		}
+	}()
+
+	return ch
+}
+
+var _ Set[int] = (*DenseSet)(nil)
+
+// All returns a sequence of the elements of the set in ascending order.
+func (d *DenseSet) All() iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := range d.bits.All() {
+			if !yield(int(i)) {
+				return
+			}
+		}
+	}
+}
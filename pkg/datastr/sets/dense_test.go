@@ -0,0 +1,53 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package sets
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDenseSet(t *testing.T) {
+	s := Dense(9, 3, 3, 130)
+
+	if s.Len() != 3 || !s.Has(130) || s.Has(4) || s.Has(-1) {
+		t.Errorf("Dense() built a wrong set: %v", s.Values())
+	}
+	if !reflect.DeepEqual(s.Values(), []int{3, 9, 130}) {
+		t.Errorf("Values() = %v, want [3 9 130]", s.Values())
+	}
+
+	s.Remove(9)
+	s.Remove(9)
+	s.Remove(-5)
+	if s.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", s.Len())
+	}
+	if !s.Equals(Comparable(130, 3)) || !s.Equals(Dense(3, 130)) {
+		t.Errorf("Equals() = false for the same elements")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Add() didn't panic on a negative value")
+		}
+	}()
+	s.Add(-1)
+}
+
+func TestDenseSet_ZeroValue(t *testing.T) {
+	var s DenseSet
+	if s.Has(1) || s.Len() != 0 || len(s.Values()) != 0 || !s.Equals(Dense()) {
+		t.Errorf("the zero value isn't an empty set: %v", s.Values())
+	}
+
+	s.Add(1)
+	s.Add(70)
+	if !reflect.DeepEqual(s.Values(), []int{1, 70}) || s.Bits().Count() != 2 {
+		t.Errorf("Values() = %v, want [1 70]", s.Values())
+	}
+	s.Clear()
+	if s.Len() != 0 || s.Has(1) {
+		t.Errorf("Clear() kept %v", s.Values())
+	}
+}
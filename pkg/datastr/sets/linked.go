@@ -0,0 +1,236 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package sets
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"iter"
+)
+
+// Linked creates a new LinkedSet from a variable number of values.
+// The set keeps the order in which the values are first added.
+func Linked[T comparable](values ...T) *LinkedSet[T] {
+	set := &LinkedSet[T]{
+		index: make(map[T]*linkedNode[T]),
+	}
+	for _, v := range values {
+		set.Add(v)
+	}
+	return set
+}
+
+// LinkedSet is a set that keeps its elements in insertion order.
+// Membership checks, insertions, removals and moves run in O(1), and Values,
+// iteration and the JSON encoding all follow the order of the elements.
+// The zero value is an empty set ready to use.
+// This isn't Thread-Safe.
+type LinkedSet[T comparable] struct {
+	index map[T]*linkedNode[T]
+	// head and tail are the first and the last nodes of the doubly linked list
+	head *linkedNode[T]
+	tail *linkedNode[T]
+}
+
+type linkedNode[T comparable] struct {
+	value T
+	prev  *linkedNode[T]
+	next  *linkedNode[T]
+}
+
+func (l *LinkedSet[T]) Has(t T) bool {
+	_, exists := l.index[t]
+	return exists
+}
+
+// Add inserts the element at the back of the set if it doesn't already exist.
+// An existing element keeps its position.
+func (l *LinkedSet[T]) Add(t T) {
+	if l.Has(t) {
+		return
+	}
+	if l.index == nil {
+		l.index = make(map[T]*linkedNode[T])
+	}
+	n := &linkedNode[T]{value: t}
+	l.index[t] = n
+	l.pushBack(n)
+}
+
+func (l *LinkedSet[T]) Remove(t T) {
+	if n, ok := l.index[t]; ok {
+		l.unlink(n)
+		delete(l.index, t)
+	}
+}
+
+func (l *LinkedSet[T]) Len() int {
+	return len(l.index)
+}
+
+// Values returns the elements in order.
+func (l *LinkedSet[T]) Values() []T {
+	values := make([]T, 0, len(l.index))
+	for n := l.head; n != nil; n = n.next {
+		values = append(values, n.value)
+	}
+	return values
+}
+
+func (l *LinkedSet[T]) Clear() {
+	l.index = make(map[T]*linkedNode[T])
+	l.head, l.tail = nil, nil
+}
+
+// Equals checks if both sets hold the same elements, regardless of their order.
+func (l *LinkedSet[T]) Equals(o Set[T]) bool {
+	if l.Len() != o.Len() {
+		return false
+	}
+	for _, v := range o.Values() {
+		if !l.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// MoveToFront moves the element to the front of the set.
+//
+// Returns:
+// - bool: True if the element exists, false otherwise.
+func (l *LinkedSet[T]) MoveToFront(t T) bool {
+	n, ok := l.index[t]
+	if !ok {
+		return false
+	}
+	if n != l.head {
+		l.unlink(n)
+		l.pushFront(n)
+	}
+	return true
+}
+
+// MoveToBack moves the element to the back of the set.
+//
+// Returns:
+// - bool: True if the element exists, false otherwise.
+func (l *LinkedSet[T]) MoveToBack(t T) bool {
+	n, ok := l.index[t]
+	if !ok {
+		return false
+	}
+	if n != l.tail {
+		l.unlink(n)
+		l.pushBack(n)
+	}
+	return true
+}
+
+// Front returns the first element of the set.
+func (l *LinkedSet[T]) Front() (T, bool) {
+	if l.head != nil {
+		return l.head.value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Back returns the last element of the set.
+func (l *LinkedSet[T]) Back() (T, bool) {
+	if l.tail != nil {
+		return l.tail.value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Walk calls the function for each element in order.
+// The walk stops when the function returns false.
+func (l *LinkedSet[T]) Walk(fn functions.Predicate[T]) {
+	for n := l.head; n != nil && fn(n.value); n = n.next {
+	}
+}
+
+func (l *LinkedSet[T]) Loop() <-chan T {
+	ch := make(chan T)
+	values := l.Values()
+
+	go func() {
+		defer close(ch)
+		for _, v := range values {
+			ch <- v
+		}
+	}()
+
+	return ch
+}
+
+// All returns a sequence of the elements of the set in order.
+func (l *LinkedSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.head; n != nil; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalJSON encodes the set as a JSON array following the order of the elements.
+func (l *LinkedSet[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.Values())
+}
+
+// UnmarshalJSON decodes a JSON array into the set, adding its elements in the order they appear.
+// Like encoding/json does with maps, the existing elements are kept.
+func (l *LinkedSet[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	for _, v := range values {
+		l.Add(v)
+	}
+	return nil
+}
+
+var _ Set[string] = (*LinkedSet[string])(nil)
+
+func (l *LinkedSet[T]) pushBack(n *linkedNode[T]) {
+	n.prev, n.next = l.tail, nil
+	if l.tail != nil {
+		l.tail.next = n
+	} else {
+		l.head = n
+	}
+	l.tail = n
+}
+
+func (l *LinkedSet[T]) pushFront(n *linkedNode[T]) {
+	n.prev, n.next = nil, l.head
+	if l.head != nil {
+		l.head.prev = n
+	} else {
+		l.tail = n
+	}
+	l.head = n
+}
+
+func (l *LinkedSet[T]) unlink(n *linkedNode[T]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		l.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		l.tail = n.prev
+	}
+	n.prev, n.next = nil, nil
+}
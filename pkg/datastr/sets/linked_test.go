@@ -0,0 +1,63 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package sets
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestLinkedSet_Order(t *testing.T) {
+	s := Linked("c", "a", "b", "a")
+
+	if !reflect.DeepEqual(s.Values(), []string{"c", "a", "b"}) {
+		t.Errorf("Values() = %v, want [c a b]", s.Values())
+	}
+
+	s.MoveToBack("c")
+	s.MoveToFront("b")
+	if !reflect.DeepEqual(s.Values(), []string{"b", "a", "c"}) {
+		t.Errorf("Values() = %v after the moves, want [b a c]", s.Values())
+	}
+
+	s.Remove("a")
+	if f, _ := s.Front(); f != "b" {
+		t.Errorf("Front() = %v, want b", f)
+	}
+	if b, _ := s.Back(); b != "c" {
+		t.Errorf("Back() = %v, want c", b)
+	}
+	if !s.Equals(Comparable("c", "b")) {
+		t.Errorf("Equals() = false for the same elements in another order")
+	}
+}
+
+func TestLinkedSet_JSON(t *testing.T) {
+	s := Linked(3, 1, 2)
+
+	data, err := json.Marshal(s)
+	if err != nil || string(data) != "[3,1,2]" {
+		t.Errorf("Marshal() = %s, %v, want [3,1,2]", data, err)
+	}
+
+	var loaded LinkedSet[int]
+	if err = json.Unmarshal([]byte("[5, 4, 5, 6]"), &loaded); err != nil {
+		t.Fatalf("Unmarshal() returned an error: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Values(), []int{5, 4, 6}) {
+		t.Errorf("Unmarshal() = %v, want [5 4 6]", loaded.Values())
+	}
+}
+
+func TestLinkedSet_All(t *testing.T) {
+	s := Linked("x", "y", "z")
+
+	var got []string
+	for v := range s.All() {
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, []string{"x", "y", "z"}) {
+		t.Errorf("All() = %v, want [x y z]", got)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/andrerrcosta2/gtools/pkg/arrays"
 	"github.com/andrerrcosta2/gtools/pkg/constraints"
 	"github.com/andrerrcosta2/gtools/pkg/search"
+	"iter"
 	"maps"
 	"sort"
 )
@@ -128,3 +129,14 @@ func (o *OrderedSet[T]) Equals(other Set[T]) bool {
 		return arrays.Equals[T](&o.items, &setValues)
 	}
 }
+
+// All returns a sequence of the elements of the set in ascending order.
+func (o *OrderedSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range o.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/andrerrcosta2/gtools/pkg/search"
 	"github.com/andrerrcosta2/gtools/pkg/sortables"
 	"github.com/andrerrcosta2/gtools/pkg/sorts"
+	"iter"
 	"maps"
 )
 
@@ -147,3 +148,20 @@ func (s *SortableOfSet[T]) Equals(other Set[T]) bool {
 func (s *SortableOfSet[T]) String() string {
 	return fmt.Sprintf("%v", s.items)
 }
+
+// All returns a sequence of the elements of the set in ascending order.
+//
+// Example of use:
+//
+//	for item := range s.All() {
+//	  fmt.Println(item)
+//	}
+func (s *SortableOfSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range s.items {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,251 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package skiplist
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/comparables"
+	"github.com/andrerrcosta2/gtools/pkg/constraints"
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"iter"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Concurrent creates a new ConcurrentSkipList ordered by the comparator, holding the values.
+// Equal values, according to the comparator, are stored only once.
+func Concurrent[T any](comparator comparables.Comparator[T], values ...T) *ConcurrentSkipList[T] {
+	s := &ConcurrentSkipList[T]{
+		comparator: comparator,
+		head:       &cnode[T]{next: make([]atomic.Pointer[cnode[T]], maxLevel)},
+		rnd:        rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	s.level.Store(1)
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// ConcurrentOrdered creates a new ConcurrentSkipList of a constraints.Ordered type in ascending order, holding the values.
+func ConcurrentOrdered[T constraints.Ordered](values ...T) *ConcurrentSkipList[T] {
+	return Concurrent[T](ascending[T](), values...)
+}
+
+// ConcurrentSkipList is a Thread-Safe sorted set ordered by a comparables.Comparator.
+//
+// Writers are serialized by a mutex, while readers take no lock at all: the links are
+// atomic pointers, nodes are linked bottom-up and unlinked top-down, and removed nodes
+// keep their links, so a reader always walks a sorted chain of nodes.
+// Reads are therefore never blocked by writes, but a reader walking concurrently with
+// a writer may or may not observe that write.
+//
+// Unlike SkipList, it doesn't support rank queries, since keeping the spans consistent
+// for lock-free readers would require locking them.
+type ConcurrentSkipList[T any] struct {
+	comparator comparables.Comparator[T]
+	head       *cnode[T]
+	level      atomic.Int32
+	size       atomic.Int64
+	// mu serializes the writers, and guards rnd
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+type cnode[T any] struct {
+	value T
+	next  []atomic.Pointer[cnode[T]]
+}
+
+// Len returns the number of elements in the list.
+func (s *ConcurrentSkipList[T]) Len() int {
+	return int(s.size.Load())
+}
+
+// Clear removes every element from the list.
+func (s *ConcurrentSkipList[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.head.next {
+		s.head.next[i].Store(nil)
+	}
+	s.level.Store(1)
+	s.size.Store(0)
+}
+
+// Add inserts the element in its sorted position.
+//
+// Returns:
+// - bool: True if the element was inserted, false if an equal element already exists.
+func (s *ConcurrentSkipList[T]) Add(t T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [maxLevel]*cnode[T]
+	x := s.predecessors(t, &update)
+	if x != nil && s.comparator.Equals(x.value, t) {
+		return false
+	}
+
+	lvl := randomLevel(s.rnd)
+	level := int(s.level.Load())
+	for i := level; i < lvl; i++ {
+		update[i] = s.head
+	}
+
+	n := &cnode[T]{value: t, next: make([]atomic.Pointer[cnode[T]], lvl)}
+	// The node is fully linked before being published, from the bottom level up,
+	// so readers reaching it always find its successors
+	for i := 0; i < lvl; i++ {
+		n.next[i].Store(update[i].next[i].Load())
+		update[i].next[i].Store(n)
+	}
+	if lvl > level {
+		s.level.Store(int32(lvl))
+	}
+
+	s.size.Add(1)
+	return true
+}
+
+// Has checks if the list contains an element equal to t.
+func (s *ConcurrentSkipList[T]) Has(t T) bool {
+	n := s.ceiling(t)
+	return n != nil && s.comparator.Equals(n.value, t)
+}
+
+// Remove deletes the element equal to t.
+//
+// Returns:
+// - bool: True if the element was found and removed, false otherwise.
+func (s *ConcurrentSkipList[T]) Remove(t T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [maxLevel]*cnode[T]
+	x := s.predecessors(t, &update)
+	if x == nil || !s.comparator.Equals(x.value, t) {
+		return false
+	}
+	s.unlink(x, &update)
+	return true
+}
+
+// RemoveRange deletes every element in the half-open range [from, to).
+//
+// Returns:
+// - int: The number of removed elements.
+func (s *ConcurrentSkipList[T]) RemoveRange(from, to T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [maxLevel]*cnode[T]
+	x := s.predecessors(from, &update)
+
+	removed := 0
+	for x != nil && s.comparator.Compare(x.value, to) < 0 {
+		next := x.next[0].Load()
+		s.unlink(x, &update)
+		x = next
+		removed++
+	}
+	return removed
+}
+
+// First returns the smallest element.
+func (s *ConcurrentSkipList[T]) First() (T, bool) {
+	if n := s.head.next[0].Load(); n != nil {
+		return n.value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Ceiling returns the smallest element greater than or equal to t.
+func (s *ConcurrentSkipList[T]) Ceiling(t T) (T, bool) {
+	if n := s.ceiling(t); n != nil {
+		return n.value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Values returns the elements in ascending order.
+// Writes happening during the call may or may not be included.
+func (s *ConcurrentSkipList[T]) Values() []T {
+	out := make([]T, 0, s.Len())
+	for n := s.head.next[0].Load(); n != nil; n = n.next[0].Load() {
+		out = append(out, n.value)
+	}
+	return out
+}
+
+// Walk calls the function for each element in ascending order.
+// The walk stops when the function returns false.
+func (s *ConcurrentSkipList[T]) Walk(fn functions.Predicate[T]) {
+	for n := s.head.next[0].Load(); n != nil && fn(n.value); n = n.next[0].Load() {
+	}
+}
+
+// Ascend calls the function for each element greater than or equal to from, in ascending order.
+// The walk stops when the function returns false.
+func (s *ConcurrentSkipList[T]) Ascend(from T, fn functions.Predicate[T]) {
+	for n := s.ceiling(from); n != nil && fn(n.value); n = n.next[0].Load() {
+	}
+}
+
+// All returns a sequence of the elements in ascending order.
+// Like Walk, it takes no lock, so writes happening during the iteration may or may not be observed.
+func (s *ConcurrentSkipList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.Walk(yield)
+	}
+}
+
+// From returns a sequence of the elements greater than or equal to t, in ascending order.
+func (s *ConcurrentSkipList[T]) From(t T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.Ascend(t, yield)
+	}
+}
+
+// ceiling returns the first node whose value is greater than or equal to t.
+func (s *ConcurrentSkipList[T]) ceiling(t T) *cnode[T] {
+	x := s.head
+	for l := int(s.level.Load()) - 1; l >= 0; l-- {
+		for next := x.next[l].Load(); next != nil && s.comparator.Compare(next.value, t) < 0; next = x.next[l].Load() {
+			x = next
+		}
+	}
+	return x.next[0].Load()
+}
+
+// predecessors fills the last node before t on every level and returns the first node not before t.
+// It must be called with the lock held.
+func (s *ConcurrentSkipList[T]) predecessors(t T, update *[maxLevel]*cnode[T]) *cnode[T] {
+	x := s.head
+	for l := int(s.level.Load()) - 1; l >= 0; l-- {
+		for next := x.next[l].Load(); next != nil && s.comparator.Compare(next.value, t) < 0; next = x.next[l].Load() {
+			x = next
+		}
+		update[l] = x
+	}
+	return x.next[0].Load()
+}
+
+// unlink removes the node from the top level down, keeping its own links
+// so readers standing on it can still move forward.
+// It must be called with the lock held.
+func (s *ConcurrentSkipList[T]) unlink(x *cnode[T], update *[maxLevel]*cnode[T]) {
+	for i := len(x.next) - 1; i >= 0; i-- {
+		update[i].next[i].Store(x.next[i].Load())
+	}
+	level := int(s.level.Load())
+	for level > 1 && s.head.next[level-1].Load() == nil {
+		level--
+	}
+	s.level.Store(int32(level))
+	s.size.Add(-1)
+}
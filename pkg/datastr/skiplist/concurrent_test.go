@@ -0,0 +1,84 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package skiplist
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentSkipList_Basics(t *testing.T) {
+	s := ConcurrentOrdered(3, 1, 2, 2)
+
+	if !reflect.DeepEqual(s.Values(), []int{1, 2, 3}) {
+		t.Errorf("Values() = %v, want [1 2 3]", s.Values())
+	}
+	if !s.Remove(2) || s.Has(2) || s.Len() != 2 {
+		t.Errorf("Remove(2) didn't remove the element")
+	}
+	for i := 10; i < 20; i++ {
+		s.Add(i)
+	}
+	if n := s.RemoveRange(12, 18); n != 6 {
+		t.Errorf("RemoveRange(12, 18) = %d, want 6", n)
+	}
+	if c, _ := s.Ceiling(12); c != 18 {
+		t.Errorf("Ceiling(12) = %d, want 18", c)
+	}
+	s.Clear()
+	if s.Len() != 0 || len(s.Values()) != 0 {
+		t.Errorf("Clear() left elements in the list")
+	}
+}
+
+func TestConcurrentSkipList_ReadersAndWriters(t *testing.T) {
+	s := ConcurrentOrdered[int]()
+	var wg sync.WaitGroup
+
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < 4000; i += 4 {
+				s.Add(i)
+				if i%3 == 0 {
+					s.Remove(i)
+				}
+			}
+		}(w)
+	}
+
+	// Readers must always observe a sorted list
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				prev := -1
+				s.Walk(func(v int) bool {
+					if v <= prev {
+						t.Errorf("Walk() visited %d after %d", v, prev)
+						return false
+					}
+					prev = v
+					return true
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	want := 0
+	for i := 0; i < 4000; i++ {
+		if i%3 != 0 {
+			want++
+			if !s.Has(i) {
+				t.Errorf("Has(%d) = false, want true", i)
+			}
+		}
+	}
+	if s.Len() != want || len(s.Values()) != want {
+		t.Errorf("Len() = %d, len(Values()) = %d, want %d", s.Len(), len(s.Values()), want)
+	}
+}
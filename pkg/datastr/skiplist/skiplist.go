@@ -0,0 +1,370 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+// Package skiplist provides sorted sets backed by skip lists.
+//
+// A skip list keeps its elements in a linked list with express lanes on top of it,
+// so insertions, removals and lookups run in O(log n) expected time without the
+// O(n) shifting of sorted slices.
+package skiplist
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/comparables"
+	"github.com/andrerrcosta2/gtools/pkg/constraints"
+	"github.com/andrerrcosta2/gtools/pkg/datastr/iterables"
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"iter"
+	"math/rand"
+	"time"
+)
+
+const (
+	// maxLevel is enough for 4^32 elements with the chosen probability
+	maxLevel = 32
+	// p is the probability of a node being promoted to the next level
+	p = 0.25
+)
+
+// Of creates a new SkipList ordered by the comparator, holding the values.
+// Equal values, according to the comparator, are stored only once.
+func Of[T any](comparator comparables.Comparator[T], values ...T) *SkipList[T] {
+	s := &SkipList[T]{
+		comparator: comparator,
+		head:       &node[T]{next: make([]*node[T], maxLevel), span: make([]int, maxLevel)},
+		level:      1,
+		rnd:        rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// Ordered creates a new SkipList of a constraints.Ordered type in ascending order, holding the values.
+func Ordered[T constraints.Ordered](values ...T) *SkipList[T] {
+	return Of[T](ascending[T](), values...)
+}
+
+// SkipList is a sorted set ordered by a comparables.Comparator.
+// Besides the set operations, it supports rank queries, since every link records how many elements it skips.
+// This isn't Thread-Safe, see ConcurrentSkipList for a concurrent variant.
+type SkipList[T any] struct {
+	comparator comparables.Comparator[T]
+	// head is a sentinel holding a link for every level
+	head  *node[T]
+	level int
+	size  int
+	rnd   *rand.Rand
+}
+
+type node[T any] struct {
+	value T
+	next  []*node[T]
+	// span[i] is the number of elements between this node and next[i], including next[i]
+	span []int
+}
+
+// Len returns the number of elements in the list.
+func (s *SkipList[T]) Len() int {
+	return s.size
+}
+
+// Clear removes every element from the list.
+func (s *SkipList[T]) Clear() {
+	s.head = &node[T]{next: make([]*node[T], maxLevel), span: make([]int, maxLevel)}
+	s.level = 1
+	s.size = 0
+}
+
+// Add inserts the element in its sorted position.
+//
+// Returns:
+// - bool: True if the element was inserted, false if an equal element already exists.
+func (s *SkipList[T]) Add(t T) bool {
+	var update [maxLevel]*node[T]
+	var rank [maxLevel]int
+
+	// Find the last node before the element on every level, along with its position
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && s.comparator.Compare(x.next[i].value, t) < 0 {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+
+	if x.next[0] != nil && s.comparator.Equals(x.next[0].value, t) {
+		return false
+	}
+
+	lvl := s.randomLevel()
+	if lvl > s.level {
+		// The new levels start at the head, whose links span the whole list
+		for i := s.level; i < lvl; i++ {
+			rank[i] = 0
+			update[i] = s.head
+			s.head.span[i] = s.size
+		}
+		s.level = lvl
+	}
+
+	n := &node[T]{value: t, next: make([]*node[T], lvl), span: make([]int, lvl)}
+	for i := 0; i < lvl; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+		// The previous span is split between the predecessor and the new node
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	// The links passing over the new node now skip one more element
+	for i := lvl; i < s.level; i++ {
+		update[i].span[i]++
+	}
+
+	s.size++
+	return true
+}
+
+// Has checks if the list contains an element equal to t.
+func (s *SkipList[T]) Has(t T) bool {
+	n := s.ceiling(t)
+	return n != nil && s.comparator.Equals(n.value, t)
+}
+
+// Remove deletes the element equal to t.
+//
+// Returns:
+// - bool: True if the element was found and removed, false otherwise.
+func (s *SkipList[T]) Remove(t T) bool {
+	var update [maxLevel]*node[T]
+	x := s.predecessors(t, &update)
+
+	if x == nil || !s.comparator.Equals(x.value, t) {
+		return false
+	}
+	s.unlink(x, &update)
+	return true
+}
+
+// RemoveRange deletes every element in the half-open range [from, to).
+// It runs in O(log n + k) expected time, where k is the number of removed elements.
+//
+// Returns:
+// - int: The number of removed elements.
+func (s *SkipList[T]) RemoveRange(from, to T) int {
+	var update [maxLevel]*node[T]
+	x := s.predecessors(from, &update)
+
+	removed := 0
+	for x != nil && s.comparator.Compare(x.value, to) < 0 {
+		next := x.next[0]
+		// The predecessors stay valid, since they all come before the removed nodes
+		s.unlink(x, &update)
+		x = next
+		removed++
+	}
+	return removed
+}
+
+// At returns the element at the index, in ascending order.
+// It runs in O(log n) expected time.
+//
+// Returns:
+// - T: The element, or the zero value if the index is out of range.
+// - bool: True if the index is in range, false otherwise.
+func (s *SkipList[T]) At(i int) (T, bool) {
+	if i < 0 || i >= s.size {
+		var zero T
+		return zero, false
+	}
+
+	// Ranks are one-based, the head being at rank zero
+	target, traversed := i+1, 0
+	x := s.head
+	for l := s.level - 1; l >= 0; l-- {
+		for x.next[l] != nil && traversed+x.span[l] <= target {
+			traversed += x.span[l]
+			x = x.next[l]
+		}
+		if traversed == target {
+			return x.value, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// IndexOf returns the position of the element equal to t, in ascending order, or -1 if it doesn't exist.
+// It runs in O(log n) expected time.
+func (s *SkipList[T]) IndexOf(t T) int {
+	rank := 0
+	x := s.head
+	for l := s.level - 1; l >= 0; l-- {
+		for x.next[l] != nil && s.comparator.Compare(x.next[l].value, t) <= 0 {
+			rank += x.span[l]
+			x = x.next[l]
+		}
+		if x != s.head && s.comparator.Equals(x.value, t) {
+			return rank - 1
+		}
+	}
+	return -1
+}
+
+// First returns the smallest element.
+func (s *SkipList[T]) First() (T, bool) {
+	if n := s.head.next[0]; n != nil {
+		return n.value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Last returns the greatest element.
+func (s *SkipList[T]) Last() (T, bool) {
+	x := s.head
+	for l := s.level - 1; l >= 0; l-- {
+		for x.next[l] != nil {
+			x = x.next[l]
+		}
+	}
+	if x != s.head {
+		return x.value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Ceiling returns the smallest element greater than or equal to t.
+func (s *SkipList[T]) Ceiling(t T) (T, bool) {
+	if n := s.ceiling(t); n != nil {
+		return n.value, true
+	}
+	var zero T
+	return zero, false
+}
+
+// Values returns the elements in ascending order.
+func (s *SkipList[T]) Values() []T {
+	out := make([]T, 0, s.size)
+	for n := s.head.next[0]; n != nil; n = n.next[0] {
+		out = append(out, n.value)
+	}
+	return out
+}
+
+// Walk calls the function for each element in ascending order.
+// The walk stops when the function returns false.
+func (s *SkipList[T]) Walk(fn functions.Predicate[T]) {
+	for n := s.head.next[0]; n != nil && fn(n.value); n = n.next[0] {
+	}
+}
+
+// Ascend calls the function for each element greater than or equal to from, in ascending order.
+// The walk stops when the function returns false.
+func (s *SkipList[T]) Ascend(from T, fn functions.Predicate[T]) {
+	for n := s.ceiling(from); n != nil && fn(n.value); n = n.next[0] {
+	}
+}
+
+// All returns a sequence of the elements in ascending order.
+func (s *SkipList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.Walk(yield)
+	}
+}
+
+// From returns a sequence of the elements greater than or equal to t, in ascending order.
+func (s *SkipList[T]) From(t T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.Ascend(t, yield)
+	}
+}
+
+// Iterator returns an iterator over the elements in ascending order.
+// The list must not be modified while the iterator is in use.
+func (s *SkipList[T]) Iterator() iterables.Iterator[T] {
+	return &Iterator[T]{current: s.head.next[0]}
+}
+
+// ceiling returns the first node whose value is greater than or equal to t.
+func (s *SkipList[T]) ceiling(t T) *node[T] {
+	x := s.head
+	for l := s.level - 1; l >= 0; l-- {
+		for x.next[l] != nil && s.comparator.Compare(x.next[l].value, t) < 0 {
+			x = x.next[l]
+		}
+	}
+	return x.next[0]
+}
+
+// predecessors fills the last node before t on every level and returns the first node not before t.
+func (s *SkipList[T]) predecessors(t T, update *[maxLevel]*node[T]) *node[T] {
+	x := s.head
+	for l := s.level - 1; l >= 0; l-- {
+		for x.next[l] != nil && s.comparator.Compare(x.next[l].value, t) < 0 {
+			x = x.next[l]
+		}
+		update[l] = x
+	}
+	return x.next[0]
+}
+
+// unlink removes the node, given its predecessors on every level.
+func (s *SkipList[T]) unlink(x *node[T], update *[maxLevel]*node[T]) {
+	for i := 0; i < s.level; i++ {
+		if update[i].next[i] == x {
+			update[i].span[i] += x.span[i] - 1
+			update[i].next[i] = x.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+}
+
+func (s *SkipList[T]) randomLevel() int {
+	return randomLevel(s.rnd)
+}
+
+func randomLevel(rnd *rand.Rand) int {
+	lvl := 1
+	for lvl < maxLevel && rnd.Float64() < p {
+		lvl++
+	}
+	return lvl
+}
+
+func ascending[T constraints.Ordered]() comparables.FunctionalComparator[T] {
+	return func(a, b T) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		}
+		return 0
+	}
+}
+
+// Iterator walks the elements of a SkipList in ascending order.
+type Iterator[T any] struct {
+	current *node[T]
+}
+
+func (it *Iterator[T]) Next() (T, bool) {
+	if it.current == nil {
+		var zero T
+		return zero, false
+	}
+	v := it.current.value
+	it.current = it.current.next[0]
+	return v, true
+}
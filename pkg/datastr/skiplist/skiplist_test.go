@@ -0,0 +1,157 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package skiplist
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/comparables"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestSkipList_AddAndRemove(t *testing.T) {
+	s := Ordered(5, 1, 4, 2, 3)
+
+	if s.Add(3) {
+		t.Errorf("Add() inserted a duplicate")
+	}
+	if !reflect.DeepEqual(s.Values(), []int{1, 2, 3, 4, 5}) {
+		t.Errorf("Values() = %v, want [1 2 3 4 5]", s.Values())
+	}
+	if !s.Remove(1) || s.Remove(1) || s.Has(1) {
+		t.Errorf("Remove() didn't remove the element once")
+	}
+	if first, _ := s.First(); first != 2 {
+		t.Errorf("First() = %d, want 2", first)
+	}
+	if last, _ := s.Last(); last != 5 {
+		t.Errorf("Last() = %d, want 5", last)
+	}
+	if c, ok := s.Ceiling(6); ok {
+		t.Errorf("Ceiling(6) = %d, true, want false", c)
+	}
+}
+
+func TestSkipList_Comparator(t *testing.T) {
+	// Case insensitive, descending order
+	s := Of[string](comparables.FunctionalComparator[string](caseInsensitiveDesc), "b", "A", "c", "B")
+
+	if !reflect.DeepEqual(s.Values(), []string{"c", "b", "A"}) {
+		t.Errorf("Values() = %v, want [c b A]", s.Values())
+	}
+	if !s.Has("C") {
+		t.Errorf("Has(C) = false, want true")
+	}
+}
+
+func TestSkipList_Ranks(t *testing.T) {
+	s := Ordered[int]()
+	want := make([]int, 0, 2000)
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 2000; i++ {
+		v := r.Intn(5000)
+		if s.Add(v) {
+			want = append(want, v)
+		}
+	}
+	for i := 0; i < 500; i++ {
+		v := r.Intn(5000)
+		if s.Remove(v) {
+			idx := indexOf(want, v)
+			want = append(want[:idx], want[idx+1:]...)
+		}
+	}
+	sort.Ints(want)
+
+	if s.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", s.Len(), len(want))
+	}
+	for i, v := range want {
+		if got, ok := s.At(i); !ok || got != v {
+			t.Fatalf("At(%d) = %d, %v, want %d, true", i, got, ok, v)
+		}
+		if got := s.IndexOf(v); got != i {
+			t.Fatalf("IndexOf(%d) = %d, want %d", v, got, i)
+		}
+	}
+	if _, ok := s.At(len(want)); ok {
+		t.Errorf("At(%d) returned an element past the end", len(want))
+	}
+	if s.IndexOf(-1) != -1 {
+		t.Errorf("IndexOf(-1) = %d, want -1", s.IndexOf(-1))
+	}
+}
+
+func TestSkipList_RemoveRange(t *testing.T) {
+	s := Ordered[int]()
+	for i := 0; i < 100; i++ {
+		s.Add(i)
+	}
+
+	if n := s.RemoveRange(10, 90); n != 80 {
+		t.Errorf("RemoveRange(10, 90) = %d, want 80", n)
+	}
+	if s.Len() != 20 || s.Has(10) || !s.Has(9) || !s.Has(90) {
+		t.Errorf("RemoveRange() removed the wrong elements: %v", s.Values())
+	}
+	// The ranks must stay consistent after a range delete
+	if v, _ := s.At(10); v != 90 {
+		t.Errorf("At(10) = %d, want 90", v)
+	}
+	if s.IndexOf(99) != 19 {
+		t.Errorf("IndexOf(99) = %d, want 19", s.IndexOf(99))
+	}
+}
+
+func TestSkipList_Iteration(t *testing.T) {
+	s := Ordered(1, 3, 5, 7, 9)
+
+	var got []int
+	s.Ascend(4, func(v int) bool {
+		got = append(got, v)
+		return v < 7
+	})
+	if !reflect.DeepEqual(got, []int{5, 7}) {
+		t.Errorf("Ascend(4) = %v, want [5 7]", got)
+	}
+
+	got = got[:0]
+	it := s.Iterator()
+	for v, ok := it.Next(); ok; v, ok = it.Next() {
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, s.Values()) {
+		t.Errorf("Iterator() = %v, want %v", got, s.Values())
+	}
+}
+
+func caseInsensitiveDesc(a, b string) int {
+	return strings.Compare(strings.ToLower(b), strings.ToLower(a))
+}
+
+func indexOf(arr []int, v int) int {
+	for i, x := range arr {
+		if x == v {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestSkipList_Seqs(t *testing.T) {
+	s := Ordered(10, 20, 30, 40)
+
+	var got []int
+	for v := range s.From(15) {
+		if v > 30 {
+			break
+		}
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, []int{20, 30}) {
+		t.Errorf("From(15) = %v, want [20 30]", got)
+	}
+}
@@ -0,0 +1,382 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+// Package trie provides prefix trees for string and byte keys.
+package trie
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"iter"
+	"strings"
+)
+
+// Key is the set of key types accepted by the trees of this package.
+type Key interface {
+	~string | ~[]byte
+}
+
+// Radix creates a new, empty RadixTree.
+//
+// The tree is a compressed trie: chains of single-child nodes are merged into
+// one edge, so lookups walk at most one node per distinct branching point.
+// Keys are compared byte-wise and iteration always follows lexicographic order.
+func Radix[K Key, V any]() *RadixTree[K, V] {
+	// The root node holds an empty prefix and is never removed.
+	return &RadixTree[K, V]{
+		root: &node[V]{},
+	}
+}
+
+// RadixTree is a compressed prefix tree mapping keys of type K to values of type V.
+// This isn't Thread-Safe.
+type RadixTree[K Key, V any] struct {
+	root *node[V]
+	size int
+}
+
+type node[V any] struct {
+	// prefix is the label of the edge leading into this node
+	prefix string
+	// leaf indicates the path up to this node is a stored key
+	leaf  bool
+	value V
+	// edges are kept sorted by the first byte of their prefixes
+	edges []*node[V]
+}
+
+// Len returns the number of keys stored in the tree.
+func (t *RadixTree[K, V]) Len() int {
+	return t.size
+}
+
+// Clear removes every key from the tree.
+func (t *RadixTree[K, V]) Clear() {
+	t.root = &node[V]{}
+	t.size = 0
+}
+
+// Insert adds a key-value pair to the tree.
+// If the key already exists, its value is replaced.
+//
+// Parameters:
+// - key: The key to insert.
+// - value: The value associated with the key.
+//
+// Returns:
+// - V: The previous value of the key, or the zero value if it didn't exist.
+// - bool: True if an existing value was replaced, false otherwise.
+func (t *RadixTree[K, V]) Insert(key K, value V) (V, bool) {
+	var zero V
+	s := string(key)
+	n := t.root
+
+	for {
+		// The whole key was consumed, so this node represents it
+		if len(s) == 0 {
+			old, existed := n.value, n.leaf
+			n.leaf, n.value = true, value
+			if !existed {
+				t.size++
+				return zero, false
+			}
+			return old, true
+		}
+
+		i, child := n.child(s[0])
+		// There's no edge starting with this byte, so the remainder becomes a new leaf
+		if child == nil {
+			n.insertEdge(i, &node[V]{prefix: s, leaf: true, value: value})
+			t.size++
+			return zero, false
+		}
+
+		common := commonPrefix(s, child.prefix)
+		// The edge is fully matched, keep descending
+		if common == len(child.prefix) {
+			s = s[common:]
+			n = child
+			continue
+		}
+
+		// The edge is partially matched, so it must be split at the divergence point
+		split := &node[V]{prefix: child.prefix[:common]}
+		child.prefix = child.prefix[common:]
+		split.edges = []*node[V]{child}
+		n.edges[i] = split
+
+		s = s[common:]
+		if len(s) == 0 {
+			split.leaf, split.value = true, value
+		} else {
+			j, _ := split.child(s[0])
+			split.insertEdge(j, &node[V]{prefix: s, leaf: true, value: value})
+		}
+		t.size++
+		return zero, false
+	}
+}
+
+// Get returns the value associated with the key.
+//
+// Returns:
+// - V: The value of the key, or the zero value if it doesn't exist.
+// - bool: True if the key exists, false otherwise.
+func (t *RadixTree[K, V]) Get(key K) (V, bool) {
+	if n := t.find(string(key)); n != nil && n.leaf {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains checks if the key exists in the tree.
+func (t *RadixTree[K, V]) Contains(key K) bool {
+	n := t.find(string(key))
+	return n != nil && n.leaf
+}
+
+// Delete removes the key from the tree, merging the nodes left with a single child.
+//
+// Returns:
+// - V: The removed value, or the zero value if the key didn't exist.
+// - bool: True if the key was removed, false otherwise.
+func (t *RadixTree[K, V]) Delete(key K) (V, bool) {
+	var zero V
+	s := string(key)
+
+	// Track the parent, since it can be merged after the removal
+	var parent *node[V]
+	n := t.root
+	for len(s) > 0 {
+		_, child := n.child(s[0])
+		if child == nil || !strings.HasPrefix(s, child.prefix) {
+			return zero, false
+		}
+		parent, n = n, child
+		s = s[len(child.prefix):]
+	}
+
+	if !n.leaf {
+		return zero, false
+	}
+
+	old := n.value
+	n.leaf, n.value = false, zero
+	t.size--
+
+	// The root is never removed nor merged
+	if parent == nil {
+		return old, true
+	}
+
+	switch len(n.edges) {
+	case 0:
+		// The node became useless, so remove it from its parent
+		parent.removeEdge(n.prefix[0])
+		// The parent may now be a non-leaf passthrough node
+		if parent != t.root && !parent.leaf && len(parent.edges) == 1 {
+			parent.merge()
+		}
+	case 1:
+		// The node is now a passthrough, so absorb its only child
+		n.merge()
+	}
+
+	return old, true
+}
+
+// LongestPrefix finds the longest stored key that is a prefix of the given key.
+// This is the lookup used for route matching, where "/api/users/10" resolves to "/api/users".
+//
+// Returns:
+// - K: The longest matching key.
+// - V: The value associated with the matching key.
+// - bool: True if any stored key is a prefix of the given key, false otherwise.
+func (t *RadixTree[K, V]) LongestPrefix(key K) (K, V, bool) {
+	s := string(key)
+	n := t.root
+
+	// The root holds the empty key
+	matched, last, found := 0, n, n.leaf
+	consumed := 0
+
+	for len(s) > 0 {
+		_, child := n.child(s[0])
+		if child == nil || !strings.HasPrefix(s, child.prefix) {
+			break
+		}
+		consumed += len(child.prefix)
+		s = s[len(child.prefix):]
+		n = child
+		if n.leaf {
+			matched, last, found = consumed, n, true
+		}
+	}
+
+	if !found {
+		var zk K
+		var zv V
+		return zk, zv, false
+	}
+	return K(string(key)[:matched]), last.value, true
+}
+
+// WalkPrefix calls fn for every key starting with the given prefix, in lexicographic order.
+// The walk stops as soon as fn returns false.
+func (t *RadixTree[K, V]) WalkPrefix(prefix K, fn functions.BiPredicate[K, V]) {
+	s := string(prefix)
+	n := t.root
+	path := make([]byte, 0, len(s))
+
+	for len(s) > 0 {
+		_, child := n.child(s[0])
+		if child == nil {
+			return
+		}
+		if strings.HasPrefix(s, child.prefix) {
+			// The edge is fully covered by the prefix
+			s = s[len(child.prefix):]
+		} else if strings.HasPrefix(child.prefix, s) {
+			// The prefix ends in the middle of the edge, so the whole subtree matches
+			s = ""
+		} else {
+			return
+		}
+		path = append(path, child.prefix...)
+		n = child
+	}
+
+	walk(n, path, fn)
+}
+
+// Walk calls fn for every key in the tree, in lexicographic order.
+// The walk stops as soon as fn returns false.
+func (t *RadixTree[K, V]) Walk(fn functions.BiPredicate[K, V]) {
+	walk(t.root, nil, fn)
+}
+
+// Keys returns all the keys in lexicographic order.
+func (t *RadixTree[K, V]) Keys() []K {
+	out := make([]K, 0, t.size)
+	t.Walk(func(k K, _ V) bool {
+		out = append(out, k)
+		return true
+	})
+	return out
+}
+
+// Values returns all the values ordered by their keys.
+func (t *RadixTree[K, V]) Values() []V {
+	out := make([]V, 0, t.size)
+	t.Walk(func(_ K, v V) bool {
+		out = append(out, v)
+		return true
+	})
+	return out
+}
+
+// All returns a sequence of the key-value pairs in lexicographic order.
+func (t *RadixTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.Walk(yield)
+	}
+}
+
+// PrefixSeq returns a sequence of the key-value pairs whose keys start with the given prefix,
+// in lexicographic order.
+func (t *RadixTree[K, V]) PrefixSeq(prefix K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.WalkPrefix(prefix, yield)
+	}
+}
+
+// KeysSeq returns a sequence of the keys in lexicographic order.
+func (t *RadixTree[K, V]) KeysSeq() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		t.Walk(func(k K, _ V) bool {
+			return yield(k)
+		})
+	}
+}
+
+// ValuesSeq returns a sequence of the values ordered by their keys.
+func (t *RadixTree[K, V]) ValuesSeq() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		t.Walk(func(_ K, v V) bool {
+			return yield(v)
+		})
+	}
+}
+
+// find returns the node that exactly matches the key, or nil if there's none.
+func (t *RadixTree[K, V]) find(s string) *node[V] {
+	n := t.root
+	for len(s) > 0 {
+		_, child := n.child(s[0])
+		if child == nil || !strings.HasPrefix(s, child.prefix) {
+			return nil
+		}
+		s = s[len(child.prefix):]
+		n = child
+	}
+	return n
+}
+
+// walk visits the subtree rooted at n depth-first, which yields keys in lexicographic order
+// because edges are sorted and a node's own key precedes the keys of its descendants.
+func walk[K Key, V any](n *node[V], path []byte, fn functions.BiPredicate[K, V]) bool {
+	if n.leaf && !fn(K(string(path)), n.value) {
+		return false
+	}
+	for _, e := range n.edges {
+		if !walk(e, append(path, e.prefix...), fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// child binary searches the edge starting with the byte b.
+// It returns the position of the edge, or the position where it should be inserted, and the edge itself if found.
+func (n *node[V]) child(b byte) (int, *node[V]) {
+	low, high := 0, len(n.edges)
+	for low < high {
+		mid := (low + high) / 2
+		if n.edges[mid].prefix[0] < b {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	if low < len(n.edges) && n.edges[low].prefix[0] == b {
+		return low, n.edges[low]
+	}
+	return low, nil
+}
+
+func (n *node[V]) insertEdge(i int, e *node[V]) {
+	n.edges = append(n.edges, nil)
+	copy(n.edges[i+1:], n.edges[i:])
+	n.edges[i] = e
+}
+
+func (n *node[V]) removeEdge(b byte) {
+	if i, e := n.child(b); e != nil {
+		n.edges = append(n.edges[:i], n.edges[i+1:]...)
+	}
+}
+
+// merge absorbs the only child of the node, concatenating their prefixes.
+func (n *node[V]) merge() {
+	child := n.edges[0]
+	n.prefix += child.prefix
+	n.leaf, n.value, n.edges = child.leaf, child.value, child.edges
+}
+
+// commonPrefix returns the length of the longest common prefix of a and b.
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,208 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package trie
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/arrays"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestRadixTree_InsertAndGet(t *testing.T) {
+	tree := Radix[string, int]()
+
+	keys := []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "r", ""}
+	for i, k := range keys {
+		if _, replaced := tree.Insert(k, i); replaced {
+			t.Errorf("Insert(%q) reported a replacement on a new key", k)
+		}
+	}
+
+	if tree.Len() != len(keys) {
+		t.Errorf("Len() = %d, want %d", tree.Len(), len(keys))
+	}
+
+	for i, k := range keys {
+		if v, ok := tree.Get(k); !ok || v != i {
+			t.Errorf("Get(%q) = %v, %v, want %v, true", k, v, ok, i)
+		}
+	}
+
+	for _, k := range []string{"rom", "roman", "rubiconx", "x"} {
+		if tree.Contains(k) {
+			t.Errorf("Contains(%q) = true, want false", k)
+		}
+	}
+
+	old, replaced := tree.Insert("romulus", 100)
+	if !replaced || old != 2 {
+		t.Errorf("Insert() = %v, %v, want 2, true", old, replaced)
+	}
+	if tree.Len() != len(keys) {
+		t.Errorf("Len() = %d after a replacement, want %d", tree.Len(), len(keys))
+	}
+}
+
+func TestRadixTree_Delete(t *testing.T) {
+	tree := Radix[string, string]()
+
+	keys := make([]string, 0, 200)
+	for i := 0; i < 200; i++ {
+		k := randomKey(rand.Intn(8) + 1)
+		if !tree.Contains(k) {
+			keys = append(keys, k)
+		}
+		tree.Insert(k, k)
+	}
+
+	rand.Shuffle(len(keys), func(i, j int) { keys[i], keys[j] = keys[j], keys[i] })
+
+	for i, k := range keys {
+		if v, ok := tree.Delete(k); !ok || v != k {
+			t.Fatalf("Delete(%q) = %v, %v, want %v, true", k, v, ok, k)
+		}
+		if tree.Contains(k) {
+			t.Fatalf("Contains(%q) = true after deletion", k)
+		}
+		// The remaining keys must still be reachable after the merges
+		for _, r := range keys[i+1:] {
+			if v, ok := tree.Get(r); !ok || v != r {
+				t.Fatalf("Get(%q) = %v, %v after deleting %q", r, v, ok, k)
+			}
+		}
+	}
+
+	if tree.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", tree.Len())
+	}
+
+	if _, ok := tree.Delete("missing"); ok {
+		t.Errorf("Delete() of a missing key returned true")
+	}
+}
+
+func TestRadixTree_LongestPrefix(t *testing.T) {
+	tree := Radix[string, string]()
+	tree.Insert("/", "root")
+	tree.Insert("/api", "api")
+	tree.Insert("/api/users", "users")
+	tree.Insert("/api/users/admin", "admin")
+
+	tests := []struct {
+		path  string
+		key   string
+		value string
+		found bool
+	}{
+		{"/api/users/10", "/api/users", "users", true},
+		{"/api/users/admin/roles", "/api/users/admin", "admin", true},
+		{"/api/user", "/api", "api", true},
+		{"/static/app.js", "/", "root", true},
+		{"api", "", "", false},
+	}
+
+	for _, tt := range tests {
+		k, v, ok := tree.LongestPrefix(tt.path)
+		if k != tt.key || v != tt.value || ok != tt.found {
+			t.Errorf("LongestPrefix(%q) = %q, %q, %v, want %q, %q, %v", tt.path, k, v, ok, tt.key, tt.value, tt.found)
+		}
+	}
+}
+
+func TestRadixTree_WalkPrefix(t *testing.T) {
+	tree := Radix[string, bool]()
+	for _, k := range []string{"user:read", "user:write", "user", "admin:all", "users:list"} {
+		tree.Insert(k, true)
+	}
+
+	var got []string
+	tree.WalkPrefix("user:", func(k string, _ bool) bool {
+		got = append(got, k)
+		return true
+	})
+	exp := []string{"user:read", "user:write"}
+	if !arrays.Equals(&got, &exp) {
+		t.Errorf("WalkPrefix(\"user:\") = %v, want %v", got, exp)
+	}
+
+	// The prefix ends in the middle of an edge
+	got = nil
+	tree.WalkPrefix("us", func(k string, _ bool) bool {
+		got = append(got, k)
+		return true
+	})
+	exp = []string{"user", "user:read", "user:write", "users:list"}
+	if !arrays.Equals(&got, &exp) {
+		t.Errorf("WalkPrefix(\"us\") = %v, want %v", got, exp)
+	}
+
+	// The walk must stop when the function returns false
+	got = nil
+	tree.WalkPrefix("", func(k string, _ bool) bool {
+		got = append(got, k)
+		return len(got) < 2
+	})
+	if len(got) != 2 {
+		t.Errorf("WalkPrefix() visited %d keys after stopping, want 2", len(got))
+	}
+}
+
+func TestRadixTree_OrderedKeys(t *testing.T) {
+	tree := Radix[[]byte, int]()
+
+	keys := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		k := randomKey(rand.Intn(6) + 1)
+		if !tree.Contains([]byte(k)) {
+			keys = append(keys, k)
+		}
+		tree.Insert([]byte(k), i)
+	}
+	sort.Strings(keys)
+
+	got := tree.Keys()
+	if len(got) != len(keys) {
+		t.Fatalf("Keys() returned %d keys, want %d", len(got), len(keys))
+	}
+	for i := range keys {
+		if string(got[i]) != keys[i] {
+			t.Errorf("Keys()[%d] = %q, want %q", i, got[i], keys[i])
+		}
+	}
+}
+
+func randomKey(n int) string {
+	const alphabet = "abc"
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = alphabet[rand.Intn(len(alphabet))]
+	}
+	return string(b)
+}
+
+func TestRadixTree_PrefixSeq(t *testing.T) {
+	tree := Radix[string, int]()
+	for i, k := range []string{"team", "tea", "ten", "toast", "apple"} {
+		tree.Insert(k, i)
+	}
+
+	var got []string
+	for k := range tree.PrefixSeq("te") {
+		got = append(got, k)
+	}
+	if want := []string{"tea", "team", "ten"}; !arrays.Equals(&got, &want) {
+		t.Errorf("PrefixSeq(te) = %v, want %v", got, want)
+	}
+
+	n := 0
+	for range tree.All() {
+		n++
+		if n == 2 {
+			break
+		}
+	}
+	if n != 2 {
+		t.Errorf("All() didn't stop after break")
+	}
+}
@@ -0,0 +1,141 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+// Package either: this package holds typed unions, values being one of a few types.
+//
+// 1. Either holds one of two types. By convention, Right holds the expected outcome.
+// 2. OneOf3 holds one of three types.
+// 3. Match and Fold handle every case, so no type assertion is needed.
+package either
+
+import (
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+)
+
+// Either holds either a value of type L or a value of type R.
+// The zero value holds the zero value of L.
+type Either[L any, R any] struct {
+	left  L
+	right R
+	isR   bool
+}
+
+// Left returns an Either holding the value of type L.
+func Left[L any, R any](value L) Either[L, R] {
+	return Either[L, R]{left: value}
+}
+
+// Right returns an Either holding the value of type R.
+func Right[L any, R any](value R) Either[L, R] {
+	return Either[L, R]{right: value, isR: true}
+}
+
+// IsLeft checks if the Either holds a value of type L.
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isR
+}
+
+// IsRight checks if the Either holds a value of type R.
+func (e Either[L, R]) IsRight() bool {
+	return e.isR
+}
+
+// Left returns the value of type L, and whether the Either holds it.
+func (e Either[L, R]) Left() (L, bool) {
+	return e.left, !e.isR
+}
+
+// Right returns the value of type R, and whether the Either holds it.
+func (e Either[L, R]) Right() (R, bool) {
+	return e.right, e.isR
+}
+
+// LeftOrElse returns the value of type L if the Either holds it, otherwise it returns the provided value.
+func (e Either[L, R]) LeftOrElse(value L) L {
+	if e.isR {
+		return value
+	}
+	return e.left
+}
+
+// RightOrElse returns the value of type R if the Either holds it, otherwise it returns the provided value.
+func (e Either[L, R]) RightOrElse(value R) R {
+	if e.isR {
+		return e.right
+	}
+	return value
+}
+
+// Swap returns an Either holding the same value with the types swapped.
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{left: e.right, right: e.left, isR: !e.isR}
+}
+
+func (e Either[L, R]) String() string {
+	if e.isR {
+		return fmt.Sprintf("Right(%v)", e.right)
+	}
+	return fmt.Sprintf("Left(%v)", e.left)
+}
+
+// Match calls onLeft or onRight with the value the Either holds.
+func Match[L any, R any](e Either[L, R], onLeft functions.Consumer[L], onRight functions.Consumer[R]) {
+	if e.isR {
+		onRight(e.right)
+		return
+	}
+	onLeft(e.left)
+}
+
+// Fold returns the result of onLeft or onRight applied to the value the Either holds.
+//
+// Parameters:
+// - e: The Either to fold.
+// - onLeft: The function applied to a value of type L.
+// - onRight: The function applied to a value of type R.
+//
+// Returns:
+// - T: The result of the function applied.
+func Fold[L any, R any, T any](e Either[L, R], onLeft functions.Function[L, T], onRight functions.Function[R, T]) T {
+	if e.isR {
+		return onRight(e.right)
+	}
+	return onLeft(e.left)
+}
+
+// MapLeft returns an Either holding f applied to the value of type L, or the same value of type R.
+func MapLeft[L any, R any, T any](e Either[L, R], f functions.Function[L, T]) Either[T, R] {
+	if e.isR {
+		return Either[T, R]{right: e.right, isR: true}
+	}
+	return Either[T, R]{left: f(e.left)}
+}
+
+// MapRight returns an Either holding f applied to the value of type R, or the same value of type L.
+func MapRight[L any, R any, T any](e Either[L, R], f functions.Function[R, T]) Either[L, T] {
+	if !e.isR {
+		return Either[L, T]{left: e.left}
+	}
+	return Either[L, T]{right: f(e.right), isR: true}
+}
+
+// FlatMap returns the Either returned by f applied to the value of type R, or the same value of type L.
+func FlatMap[L any, R any, T any](e Either[L, R], f functions.Function[R, Either[L, T]]) Either[L, T] {
+	if !e.isR {
+		return Either[L, T]{left: e.left}
+	}
+	return f(e.right)
+}
+
+// Partition splits the values of the Eithers by their type, keeping their order.
+func Partition[L any, R any](es []Either[L, R]) ([]L, []R) {
+	lefts, rights := make([]L, 0), make([]R, 0)
+	for _, e := range es {
+		if e.isR {
+			rights = append(rights, e.right)
+		} else {
+			lefts = append(lefts, e.left)
+		}
+	}
+	return lefts, rights
+}
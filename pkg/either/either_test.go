@@ -0,0 +1,131 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package either
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+type diagnostic struct {
+	line int
+	msg  string
+}
+
+func parse(src string) Either[[]diagnostic, []int] {
+	var values []int
+	var diags []diagnostic
+	for i, field := range strings.Fields(src) {
+		v, err := strconv.Atoi(field)
+		if err != nil {
+			diags = append(diags, diagnostic{line: i, msg: err.Error()})
+			continue
+		}
+		values = append(values, v)
+	}
+	if len(diags) > 0 {
+		return Left[[]diagnostic, []int](diags)
+	}
+	return Right[[]diagnostic](values)
+}
+
+func TestEither(t *testing.T) {
+	ok, failed := parse("1 2 3"), parse("1 x y")
+
+	if !ok.IsRight() || failed.IsRight() {
+		t.Fatalf("IsRight() = %v and %v, want true and false", ok.IsRight(), failed.IsRight())
+	}
+	if values, _ := ok.Right(); !reflect.DeepEqual(values, []int{1, 2, 3}) {
+		t.Errorf("Right() = %v, want [1 2 3]", values)
+	}
+	if diags, isLeft := failed.Left(); !isLeft || len(diags) != 2 {
+		t.Errorf("Left() = %v, %v, want 2 diagnostics", diags, isLeft)
+	}
+
+	summary := func(e Either[[]diagnostic, []int]) string {
+		return Fold(e,
+			func(diags []diagnostic) string { return strconv.Itoa(len(diags)) + " errors" },
+			func(values []int) string { return strconv.Itoa(len(values)) + " values" })
+	}
+	if summary(ok) != "3 values" || summary(failed) != "2 errors" {
+		t.Errorf("Fold() = %s and %s", summary(ok), summary(failed))
+	}
+
+	var matched []string
+	for _, e := range []Either[[]diagnostic, []int]{ok, failed} {
+		Match(e, func([]diagnostic) { matched = append(matched, "left") }, func([]int) { matched = append(matched, "right") })
+	}
+	if !reflect.DeepEqual(matched, []string{"right", "left"}) {
+		t.Errorf("Match() = %v, want [right left]", matched)
+	}
+}
+
+func TestEither_Transform(t *testing.T) {
+	e := Right[error](21)
+	doubled := MapRight(e, func(v int) int { return v * 2 })
+	if doubled.RightOrElse(0) != 42 {
+		t.Errorf("MapRight() = %v, want Right(42)", doubled)
+	}
+
+	failed := Left[error, int](errors.New("boom"))
+	if MapRight(failed, func(v int) int { return v * 2 }).IsRight() {
+		t.Errorf("MapRight() of a Left is a Right")
+	}
+	msg := MapLeft(failed, func(err error) string { return err.Error() })
+	if msg.LeftOrElse("") != "boom" || msg.String() != "Left(boom)" {
+		t.Errorf("MapLeft() = %v, want Left(boom)", msg)
+	}
+
+	half := func(v int) Either[error, int] {
+		if v%2 != 0 {
+			return Left[error, int](errors.New("odd"))
+		}
+		return Right[error](v / 2)
+	}
+	if FlatMap(e, half).IsRight() || FlatMap(doubled, half).RightOrElse(0) != 21 {
+		t.Errorf("FlatMap() didn't return the Either of the function")
+	}
+	if s := Right[string](1).Swap(); !s.IsLeft() || s.LeftOrElse(0) != 1 {
+		t.Errorf("Swap() = %v, want Left(1)", s)
+	}
+
+	lefts, rights := Partition([]Either[string, int]{Left[string, int]("a"), Right[string](1), Right[string](2)})
+	if !reflect.DeepEqual(lefts, []string{"a"}) || !reflect.DeepEqual(rights, []int{1, 2}) {
+		t.Errorf("Partition() = %v, %v", lefts, rights)
+	}
+}
+
+func TestOneOf3(t *testing.T) {
+	values := []OneOf3[int, string, bool]{
+		First[int, string, bool](1),
+		Second[int, string, bool]("two"),
+		Third[int, string](true),
+	}
+
+	var got []string
+	for _, v := range values {
+		got = append(got, Fold3(v,
+			func(i int) string { return "int " + strconv.Itoa(i) },
+			func(s string) string { return "string " + s },
+			func(b bool) string { return "bool " + strconv.FormatBool(b) }))
+	}
+	if !reflect.DeepEqual(got, []string{"int 1", "string two", "bool true"}) {
+		t.Errorf("Fold3() = %v", got)
+	}
+
+	if s, ok := values[1].Second(); !ok || s != "two" || values[1].Index() != 1 {
+		t.Errorf("Second() = %v, %v", s, ok)
+	}
+	if _, ok := values[2].First(); ok {
+		t.Errorf("First() of a Third value returned true")
+	}
+
+	count := 0
+	Match3(values[2], func(int) {}, func(string) {}, func(bool) { count++ })
+	if count != 1 || values[2].String() != "Third(true)" {
+		t.Errorf("Match3() didn't call the function of the third type")
+	}
+}
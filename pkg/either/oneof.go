@@ -0,0 +1,87 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package either
+
+import (
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+)
+
+// OneOf3 holds a value of type A, B or C.
+// The zero value holds the zero value of A.
+type OneOf3[A any, B any, C any] struct {
+	a   A
+	b   B
+	c   C
+	idx int
+}
+
+// First returns a OneOf3 holding the value of type A.
+func First[A any, B any, C any](value A) OneOf3[A, B, C] {
+	return OneOf3[A, B, C]{a: value}
+}
+
+// Second returns a OneOf3 holding the value of type B.
+func Second[A any, B any, C any](value B) OneOf3[A, B, C] {
+	return OneOf3[A, B, C]{b: value, idx: 1}
+}
+
+// Third returns a OneOf3 holding the value of type C.
+func Third[A any, B any, C any](value C) OneOf3[A, B, C] {
+	return OneOf3[A, B, C]{c: value, idx: 2}
+}
+
+// Index returns the position of the type of the value the OneOf3 holds: 0 for A, 1 for B and 2 for C.
+func (o OneOf3[A, B, C]) Index() int {
+	return o.idx
+}
+
+// First returns the value of type A, and whether the OneOf3 holds it.
+func (o OneOf3[A, B, C]) First() (A, bool) {
+	return o.a, o.idx == 0
+}
+
+// Second returns the value of type B, and whether the OneOf3 holds it.
+func (o OneOf3[A, B, C]) Second() (B, bool) {
+	return o.b, o.idx == 1
+}
+
+// Third returns the value of type C, and whether the OneOf3 holds it.
+func (o OneOf3[A, B, C]) Third() (C, bool) {
+	return o.c, o.idx == 2
+}
+
+func (o OneOf3[A, B, C]) String() string {
+	switch o.idx {
+	case 1:
+		return fmt.Sprintf("Second(%v)", o.b)
+	case 2:
+		return fmt.Sprintf("Third(%v)", o.c)
+	default:
+		return fmt.Sprintf("First(%v)", o.a)
+	}
+}
+
+// Match3 calls the function matching the type of the value the OneOf3 holds.
+func Match3[A any, B any, C any](o OneOf3[A, B, C], onA functions.Consumer[A], onB functions.Consumer[B], onC functions.Consumer[C]) {
+	switch o.idx {
+	case 1:
+		onB(o.b)
+	case 2:
+		onC(o.c)
+	default:
+		onA(o.a)
+	}
+}
+
+// Fold3 returns the result of the function matching the type of the value the OneOf3 holds.
+func Fold3[A any, B any, C any, T any](o OneOf3[A, B, C], onA functions.Function[A, T], onB functions.Function[B, T], onC functions.Function[C, T]) T {
+	switch o.idx {
+	case 1:
+		return onB(o.b)
+	case 2:
+		return onC(o.c)
+	default:
+		return onA(o.a)
+	}
+}
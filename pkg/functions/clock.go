@@ -0,0 +1,33 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package functions
+
+import "time"
+
+// Clock tells the time to the time-based functions of this package,
+// so they can be tested with a fake clock instead of waiting.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// AfterFunc calls f in its own goroutine once the duration has passed.
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a call scheduled by Clock.AfterFunc.
+type Timer interface {
+	// Stop prevents the call, returning false if it has already been made or stopped.
+	Stop() bool
+}
+
+// SystemClock is the Clock of the system.
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (SystemClock) AfterFunc(d time.Duration, f func()) Timer {
+	return time.AfterFunc(d, f)
+}
+
+var _ Clock = SystemClock{}
@@ -0,0 +1,81 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package functions
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// fakeClock is a Clock whose time only moves with Advance, which makes the scheduled calls.
+type fakeClock struct {
+	mtx    sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	clk     *fakeClock
+	at      time.Time
+	f       func()
+	stopped bool
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(0, 0)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) AfterFunc(d time.Duration, f func()) Timer {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	t := &fakeTimer{clk: c, at: c.now.Add(d), f: f}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance moves the time forward, making the calls scheduled until then in order.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mtx.Lock()
+	end := c.now.Add(d)
+	for {
+		sort.SliceStable(c.timers, func(i, j int) bool { return c.timers[i].at.Before(c.timers[j].at) })
+		if len(c.timers) == 0 || c.timers[0].at.After(end) {
+			break
+		}
+		t := c.timers[0]
+		c.timers = c.timers[1:]
+		c.now = t.at
+		c.mtx.Unlock()
+		t.f()
+		c.mtx.Lock()
+	}
+	c.now = end
+	c.mtx.Unlock()
+}
+
+// Pending returns the number of scheduled calls.
+func (c *fakeClock) Pending() int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return len(c.timers)
+}
+
+func (t *fakeTimer) Stop() bool {
+	c := t.clk
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	for i, other := range c.timers {
+		if other == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
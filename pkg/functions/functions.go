@@ -2,7 +2,10 @@
 
 package functions
 
-import "github.com/andrerrcosta2/gtools/pkg/gtools"
+import (
+	"github.com/andrerrcosta2/gtools/pkg/gtools"
+	"sync"
+)
 
 // Runnable represents a function that returns nothing.
 type Runnable func()
@@ -244,6 +247,8 @@ func Apply4[A, B, C, D any](f func(A, B, C, D) D, a A, b B, c C, d D) D {
 // Memoize is a higher-order function that takes a function f and returns a new function that caches the results of f.
 // The new function checks if the result for a given input x is already cached, and if so, returns the cached result instead of calling f again.
 // This can be useful for functions that have expensive computations or I/O operations.
+// The new function is safe for concurrent use, and concurrent calls with the same input share a single call to f.
+// See MemoizeWith for a bounded cache.
 //
 // Parameters:
 // - f: The function to be memoized. It takes a parameter of type T and returns a value of type R.
@@ -253,17 +258,14 @@ func Apply4[A, B, C, D any](f func(A, B, C, D) D, a A, b B, c C, d D) D {
 //     The new function checks if the result for the input x is already cached, and if so, returns the cached result.
 //     If the result is not cached, it calls f with the input x, stores the result in the cache, and returns it.
 func Memoize[T comparable, R any](f func(T) R) func(T) R {
-	cache := make(map[T]R) // Create a map to store the cached results
+	// Memoize the function as one that never fails, so the cache is safe for concurrent use
+	mem := MemoizeErr(func(x T) (R, error) {
+		return f(x), nil
+	})
 
-	return func(x T) R { // Return a new function that takes an input x and returns the result of f(x)
-		if result, ok := cache[x]; ok { // Check if the result for x is already cached
-			return result // If it is, return the cached result
-		}
-
-		result := f(x) // If the result is not cached, call f with the input x and store the result in the cache
-		cache[x] = result
-
-		return result // Return the result of f(x)
+	return func(x T) R {
+		result, _ := mem(x)
+		return result
 	}
 }
 
@@ -276,9 +278,10 @@ func Memoize[T comparable, R any](f func(T) R) func(T) R {
 // Returns:
 //   - A new function that, when called, will call the provided function `f` only once.
 //     The result of the first call to `f` is stored and returned on subsequent calls.
+//     It's safe for concurrent use. See Lazy for functions that can fail.
 func Once[T any](f Supplier[T]) Supplier[T] {
-	// Initialize a flag to track if the function has been called
-	var once bool
+	// Guard the call, so concurrent callers wait for the first one to finish
+	var once sync.Once
 
 	// Initialize a variable to store the result of the first call to `f`
 	var result T
@@ -286,10 +289,9 @@ func Once[T any](f Supplier[T]) Supplier[T] {
 	// Return a new function that will call `f` only once
 	return func() T {
 		// If the function has not been called yet, call it and store the result
-		if !once {
+		once.Do(func() {
 			result = f()
-			once = true
-		}
+		})
 
 		// Return the stored result
 		return result
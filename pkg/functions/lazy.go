@@ -0,0 +1,103 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package functions
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// NewLazy creates a Lazy value initialized by f on the first call to Get.
+// The result of f, error included, is kept for every later call.
+func NewLazy[T any](f func() (T, error)) *Lazy[T] {
+	return &Lazy[T]{f: f}
+}
+
+// NewRetryingLazy creates a Lazy value initialized by f on the first call to Get.
+// Unlike NewLazy, a failure isn't kept: f is called again on the next call to Get, until it succeeds.
+func NewRetryingLazy[T any](f func() (T, error)) *Lazy[T] {
+	return &Lazy[T]{f: f, retry: true}
+}
+
+// Lazy is a value initialized on its first use.
+// This is Thread-Safe: concurrent calls to Get wait for the one initializing the value.
+// If the initializer panics, the value stays uninitialized and the next call to Get tries again.
+type Lazy[T any] struct {
+	mtx   sync.Mutex
+	res   atomic.Pointer[lazyResult[T]]
+	f     func() (T, error)
+	retry bool
+}
+
+// lazyResult is the outcome of the initializer, replaced as a whole so Get never sees it half reset.
+type lazyResult[T any] struct {
+	val T
+	err error
+}
+
+// Get returns the value, initializing it if it's the first call.
+func (l *Lazy[T]) Get() (T, error) {
+	if r := l.res.Load(); r != nil {
+		return r.val, r.err
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if r := l.res.Load(); r != nil {
+		return r.val, r.err
+	}
+	val, err := l.f()
+	if err != nil && l.retry {
+		return val, err
+	}
+	l.res.Store(&lazyResult[T]{val: val, err: err})
+	return val, err
+}
+
+// Initialized checks if the value has been initialized.
+func (l *Lazy[T]) Initialized() bool {
+	return l.res.Load() != nil
+}
+
+// Reset drops the value, so the next call to Get initializes it again.
+func (l *Lazy[T]) Reset() {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.res.Store(nil)
+}
+
+// ResettableOnce performs an action only once, until it's reset.
+// Unlike sync.Once, an action that panics doesn't count as performed.
+// The zero value is ready to use. This is Thread-Safe.
+type ResettableOnce struct {
+	mtx  sync.Mutex
+	done atomic.Bool
+}
+
+// Do calls f if no action has been performed since the creation or the last reset.
+// Concurrent calls wait for the one performing the action.
+func (o *ResettableOnce) Do(f Runnable) {
+	if o.done.Load() {
+		return
+	}
+
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	if o.done.Load() {
+		return
+	}
+	f()
+	o.done.Store(true)
+}
+
+// Done checks if the action has been performed.
+func (o *ResettableOnce) Done() bool {
+	return o.done.Load()
+}
+
+// Reset allows the next call to Do to perform an action again.
+func (o *ResettableOnce) Reset() {
+	o.mtx.Lock()
+	defer o.mtx.Unlock()
+	o.done.Store(false)
+}
@@ -0,0 +1,139 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package functions
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestOnce_Concurrent(t *testing.T) {
+	var calls int32
+	once := Once(func() int {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(10 * time.Millisecond)
+		return 7
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v := once(); v != 7 {
+				t.Errorf("Once() = %d, want 7", v)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("the function was called %d times, want 1", calls)
+	}
+}
+
+func TestLazy(t *testing.T) {
+	var calls int32
+	lazy := NewLazy(func() (string, error) {
+		atomic.AddInt32(&calls, 1)
+		return "client", nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lazy.Get()
+		}()
+	}
+	wg.Wait()
+	if v, err := lazy.Get(); v != "client" || err != nil || calls != 1 {
+		t.Errorf("Get() = %v, %v after %d calls, want client after 1 call", v, err, calls)
+	}
+
+	lazy.Reset()
+	if lazy.Initialized() {
+		t.Errorf("Initialized() = true after Reset()")
+	}
+	lazy.Get()
+	if calls != 2 {
+		t.Errorf("Get() didn't initialize the value again after Reset()")
+	}
+}
+
+func TestLazy_ConcurrentReset(t *testing.T) {
+	var calls int32
+	lazy := NewLazy(func() ([]int, error) {
+		n := int(atomic.AddInt32(&calls, 1))
+		return []int{n, n}, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if v, err := lazy.Get(); err != nil || len(v) != 2 || v[0] != v[1] {
+					t.Errorf("Get() = %v, %v, want an initialized value", v, err)
+					return
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				lazy.Reset()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestLazy_Failures(t *testing.T) {
+	boom := errors.New("boom")
+	fail := true
+	f := func() (int, error) {
+		if fail {
+			return 0, boom
+		}
+		return 1, nil
+	}
+
+	kept := NewLazy(f)
+	retrying := NewRetryingLazy(f)
+	kept.Get()
+	retrying.Get()
+
+	fail = false
+	if _, err := kept.Get(); !errors.Is(err, boom) {
+		t.Errorf("NewLazy().Get() error = %v, want the kept failure", err)
+	}
+	if v, err := retrying.Get(); err != nil || v != 1 {
+		t.Errorf("NewRetryingLazy().Get() = %v, %v, want 1", v, err)
+	}
+}
+
+func TestResettableOnce(t *testing.T) {
+	var once ResettableOnce
+	calls := 0
+
+	func() {
+		defer func() { recover() }()
+		once.Do(func() { panic("failed") })
+	}()
+	once.Do(func() { calls++ })
+	once.Do(func() { calls++ })
+	if calls != 1 || !once.Done() {
+		t.Errorf("Do() performed %d actions, want 1", calls)
+	}
+
+	once.Reset()
+	once.Do(func() { calls++ })
+	if calls != 2 {
+		t.Errorf("Do() didn't perform the action after Reset()")
+	}
+}
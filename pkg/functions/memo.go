@@ -0,0 +1,161 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package functions
+
+import (
+	"container/list"
+	"github.com/andrerrcosta2/gtools/pkg/tuple"
+	"sync"
+	"time"
+)
+
+// MemoConfig bounds the cache of MemoizeWith.
+type MemoConfig struct {
+	// Cap is the maximum number of cached results. The least recently used one is evicted
+	// to make room for a new one. Zero means no limit.
+	Cap int
+	// TTL is how long a result stays cached. Zero means forever.
+	TTL time.Duration
+	// Clk tells the time for the TTL. Nil means the SystemClock.
+	Clk Clock
+}
+
+// MemoizeErr returns a concurrency-safe memoized version of f.
+// Only the successful results are cached, so a failing call is retried the next time.
+func MemoizeErr[T comparable, R any](f func(T) (R, error)) func(T) (R, error) {
+	return MemoizeWith(MemoConfig{}, f)
+}
+
+// MemoizeWith returns a concurrency-safe memoized version of f, with a cache bounded by the configuration.
+//
+// Concurrent calls with the same argument share a single call to f: the first one calls it,
+// and the others wait for its result. Only the successful results are cached.
+//
+// Parameters:
+// - cfg: The capacity and the TTL of the cache.
+// - f: The function to be memoized.
+//
+// Returns:
+// - A new function returning the cached result for its argument, calling f when there's none.
+func MemoizeWith[T comparable, R any](cfg MemoConfig, f func(T) (R, error)) func(T) (R, error) {
+	if cfg.Clk == nil {
+		cfg.Clk = SystemClock{}
+	}
+	m := &memo[T, R]{
+		cfg:     cfg,
+		f:       f,
+		entries: make(map[T]*list.Element),
+		lru:     list.New(),
+		calls:   make(map[T]*memoCall[R]),
+	}
+	return m.get
+}
+
+// Memoize2 returns a concurrency-safe memoized version of a function of two arguments.
+func Memoize2[A comparable, B comparable, R any](f func(A, B) R) func(A, B) R {
+	mem := Memoize(func(p tuple.Pair[A, B]) R {
+		return f(p.First(), p.Second())
+	})
+	return func(a A, b B) R {
+		return mem(*tuple.NewPair(a, b))
+	}
+}
+
+// Memoize2Err returns a concurrency-safe memoized version of a fallible function of two arguments.
+// Only the successful results are cached.
+func Memoize2Err[A comparable, B comparable, R any](f func(A, B) (R, error)) func(A, B) (R, error) {
+	mem := MemoizeErr(func(p tuple.Pair[A, B]) (R, error) {
+		return f(p.First(), p.Second())
+	})
+	return func(a A, b B) (R, error) {
+		return mem(*tuple.NewPair(a, b))
+	}
+}
+
+type memo[T comparable, R any] struct {
+	mtx     sync.Mutex
+	cfg     MemoConfig
+	f       func(T) (R, error)
+	entries map[T]*list.Element
+	// lru holds the entries from the most to the least recently used
+	lru   *list.List
+	calls map[T]*memoCall[R]
+}
+
+type memoEntry[T any, R any] struct {
+	key T
+	val R
+	exp time.Time
+}
+
+// memoCall is a call to the memoized function, shared by the concurrent calls with the same argument.
+type memoCall[R any] struct {
+	wg   sync.WaitGroup
+	val  R
+	err  error
+	done bool
+}
+
+func (m *memo[T, R]) get(x T) (R, error) {
+	for {
+		m.mtx.Lock()
+		if el, ok := m.entries[x]; ok {
+			e := el.Value.(*memoEntry[T, R])
+			if e.exp.IsZero() || m.cfg.Clk.Now().Before(e.exp) {
+				m.lru.MoveToFront(el)
+				m.mtx.Unlock()
+				return e.val, nil
+			}
+			m.remove(el)
+		}
+
+		if c, ok := m.calls[x]; ok {
+			m.mtx.Unlock()
+			c.wg.Wait()
+			// The call didn't finish when f panicked, so it's tried again
+			if c.done {
+				return c.val, c.err
+			}
+			continue
+		}
+
+		c := &memoCall[R]{}
+		c.wg.Add(1)
+		m.calls[x] = c
+		m.mtx.Unlock()
+
+		m.call(x, c)
+		return c.val, c.err
+	}
+}
+
+// call calls the memoized function, caching its result if it succeeds.
+func (m *memo[T, R]) call(x T, c *memoCall[R]) {
+	defer func() {
+		m.mtx.Lock()
+		delete(m.calls, x)
+		if c.done && c.err == nil {
+			m.store(x, c.val)
+		}
+		m.mtx.Unlock()
+		c.wg.Done()
+	}()
+	c.val, c.err = m.f(x)
+	c.done = true
+}
+
+func (m *memo[T, R]) store(x T, val R) {
+	e := &memoEntry[T, R]{key: x, val: val}
+	if m.cfg.TTL > 0 {
+		e.exp = m.cfg.Clk.Now().Add(m.cfg.TTL)
+	}
+	m.entries[x] = m.lru.PushFront(e)
+	if m.cfg.Cap > 0 && m.lru.Len() > m.cfg.Cap {
+		m.remove(m.lru.Back())
+	}
+}
+
+func (m *memo[T, R]) remove(el *list.Element) {
+	m.lru.Remove(el)
+	delete(m.entries, el.Value.(*memoEntry[T, R]).key)
+}
@@ -0,0 +1,120 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package functions
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoize_Concurrent(t *testing.T) {
+	var calls int32
+	release := make(chan struct{})
+	mem := Memoize(func(x int) int {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return x * 2
+	})
+
+	var wg sync.WaitGroup
+	results := make([]int, 20)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = mem(21)
+		}(i)
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("the function was called %d times, want 1", calls)
+	}
+	for _, r := range results {
+		if r != 42 {
+			t.Fatalf("Memoize(21) = %d, want 42", r)
+		}
+	}
+}
+
+func TestMemoizeErr_DoesNotCacheFailures(t *testing.T) {
+	fail := true
+	calls := 0
+	mem := MemoizeErr(func(x string) (int, error) {
+		calls++
+		if fail {
+			return 0, errors.New("unavailable")
+		}
+		return len(x), nil
+	})
+
+	if _, err := mem("abc"); err == nil {
+		t.Fatalf("MemoizeErr() didn't return the error")
+	}
+	fail = false
+	if r, err := mem("abc"); err != nil || r != 3 {
+		t.Errorf("MemoizeErr() = %v, %v, want 3", r, err)
+	}
+	mem("abc")
+	if calls != 2 {
+		t.Errorf("the function was called %d times, want 2", calls)
+	}
+}
+
+func TestMemoizeWith_Bounds(t *testing.T) {
+	clk := newFakeClock()
+	calls := map[int]int{}
+	mem := MemoizeWith(MemoConfig{Cap: 2, TTL: time.Minute, Clk: clk}, func(x int) (int, error) {
+		calls[x]++
+		return x, nil
+	})
+
+	mem(1)
+	mem(2)
+	mem(1)
+	// 2 is the least recently used, so it's evicted
+	mem(3)
+	mem(1)
+	mem(2)
+	if calls[1] != 1 || calls[2] != 2 {
+		t.Errorf("calls = %v, want 1 once and 2 twice", calls)
+	}
+
+	clk.Advance(2 * time.Minute)
+	mem(2)
+	if calls[2] != 3 {
+		t.Errorf("an expired result was returned, calls = %v", calls)
+	}
+}
+
+func TestMemoize2(t *testing.T) {
+	calls := 0
+	mem := Memoize2(func(a int, b string) string {
+		calls++
+		return b + string(rune('0'+a))
+	})
+
+	if r := mem(1, "x"); r != "x1" {
+		t.Errorf("Memoize2(1, x) = %v, want x1", r)
+	}
+	mem(1, "x")
+	mem(2, "x")
+	if calls != 2 {
+		t.Errorf("the function was called %d times, want 2", calls)
+	}
+
+	memErr := Memoize2Err(func(a, b int) (int, error) {
+		if b == 0 {
+			return 0, errors.New("division by zero")
+		}
+		return a / b, nil
+	})
+	if _, err := memErr(1, 0); err == nil {
+		t.Errorf("Memoize2Err(1, 0) didn't return the error")
+	}
+}
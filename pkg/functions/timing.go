@@ -0,0 +1,269 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package functions
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Edge tells on which edge of a burst of calls a debounced function is called.
+type Edge int
+
+const (
+	// Trailing calls the function once the calls stop, with the last argument.
+	Trailing Edge = iota
+	// Leading calls the function on the first call of a burst, and ignores the others.
+	Leading
+	// LeadingAndTrailing calls the function on the first call of a burst and, if there
+	// were more calls, once they stop with the last argument.
+	LeadingAndTrailing
+)
+
+// DebounceConfig configures Debounce.
+type DebounceConfig struct {
+	// Wait is how long the calls must stop for a burst to end.
+	Wait time.Duration
+	// Edge tells when the function is called. The default is Trailing.
+	Edge Edge
+	// Clk schedules the trailing calls. Nil means the SystemClock.
+	Clk Clock
+}
+
+// Debounce returns a function grouping the calls made less than cfg.Wait apart into a single call to f.
+//
+// The leading calls are made by the caller, and the trailing calls from a goroutine of the clock.
+// Once the context is done, the pending trailing call is dropped and the function does nothing.
+//
+// Parameters:
+// - ctx: The context stopping the function.
+// - cfg: The wait and the edge of the calls.
+// - f: The function to be debounced.
+//
+// Returns:
+// - The debounced function.
+func Debounce[T any](ctx context.Context, cfg DebounceConfig, f Consumer[T]) func(T) {
+	if cfg.Clk == nil {
+		cfg.Clk = SystemClock{}
+	}
+	d := &debouncer[T]{cfg: cfg, f: f}
+	context.AfterFunc(ctx, d.stop)
+	return d.call
+}
+
+type debouncer[T any] struct {
+	mtx   sync.Mutex
+	cfg   DebounceConfig
+	f     Consumer[T]
+	timer Timer
+	// gen identifies the current timer, so a timer firing while being replaced does nothing
+	gen     int
+	arg     T
+	pending bool
+	done    bool
+}
+
+func (d *debouncer[T]) call(v T) {
+	d.mtx.Lock()
+	if d.done {
+		d.mtx.Unlock()
+		return
+	}
+
+	// A call is leading when there's no burst going on
+	leading := d.timer == nil && d.cfg.Edge != Trailing
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+	d.gen++
+	gen := d.gen
+	d.timer = d.cfg.Clk.AfterFunc(d.cfg.Wait, func() { d.fire(gen) })
+
+	if leading {
+		d.mtx.Unlock()
+		d.f(v)
+		return
+	}
+	d.arg, d.pending = v, d.cfg.Edge != Leading
+	d.mtx.Unlock()
+}
+
+// fire ends the burst, making the trailing call if there's one.
+func (d *debouncer[T]) fire(gen int) {
+	d.mtx.Lock()
+	if gen != d.gen || d.done {
+		d.mtx.Unlock()
+		return
+	}
+	d.timer = nil
+	v, pending := d.arg, d.pending
+	d.arg, d.pending = *new(T), false
+	d.mtx.Unlock()
+
+	if pending {
+		d.f(v)
+	}
+}
+
+func (d *debouncer[T]) stop() {
+	d.mtx.Lock()
+	defer d.mtx.Unlock()
+	d.done = true
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+}
+
+// ThrottleConfig configures Throttle.
+type ThrottleConfig struct {
+	// Interval is the minimum time between two calls.
+	Interval time.Duration
+	// Clk tells the time. Nil means the SystemClock.
+	Clk Clock
+}
+
+// Throttle returns a function calling f at most once every cfg.Interval.
+// The calls made before the interval has passed since the last call to f are dropped,
+// as are the calls made once the context is done.
+//
+// Parameters:
+// - ctx: The context stopping the function.
+// - cfg: The interval between the calls.
+// - f: The function to be throttled.
+//
+// Returns:
+// - The throttled function, which returns true if it called f.
+func Throttle[T any](ctx context.Context, cfg ThrottleConfig, f Consumer[T]) func(T) bool {
+	if cfg.Clk == nil {
+		cfg.Clk = SystemClock{}
+	}
+	var mtx sync.Mutex
+	var last time.Time
+	return func(v T) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+		mtx.Lock()
+		now := cfg.Clk.Now()
+		if !last.IsZero() && now.Sub(last) < cfg.Interval {
+			mtx.Unlock()
+			return false
+		}
+		last = now
+		mtx.Unlock()
+
+		f(v)
+		return true
+	}
+}
+
+// Limiter limits the rate of some events.
+type Limiter interface {
+	// Allow reports whether an event may happen now, consuming the permission if so.
+	Allow() bool
+	// Wait blocks until an event may happen, or the context is done.
+	Wait(ctx context.Context) error
+}
+
+// RateLimited returns a function waiting for the limiter before calling f.
+// If the context of the call is done while waiting, it returns the context error without calling f.
+func RateLimited[T any, R any](l Limiter, f func(context.Context, T) (R, error)) func(context.Context, T) (R, error) {
+	return func(ctx context.Context, v T) (R, error) {
+		if err := l.Wait(ctx); err != nil {
+			return *new(R), err
+		}
+		return f(ctx, v)
+	}
+}
+
+// TokenBucket returns a Limiter allowing bursts of up to burst events, refilled with one event every interval.
+// The bucket starts full. A nil clock means the SystemClock.
+func TokenBucket(every time.Duration, burst int, clk Clock) *TokenBucketLimiter {
+	if clk == nil {
+		clk = SystemClock{}
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &TokenBucketLimiter{
+		every:  every,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   clk.Now(),
+		clk:    clk,
+	}
+}
+
+// TokenBucketLimiter is a token bucket Limiter.
+// This is Thread-Safe.
+type TokenBucketLimiter struct {
+	mtx    sync.Mutex
+	every  time.Duration
+	burst  float64
+	tokens float64
+	last   time.Time
+	clk    Clock
+}
+
+func (b *TokenBucketLimiter) Allow() bool {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.refill()
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (b *TokenBucketLimiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	// Take the token now, even if it isn't there yet, so the waiting calls queue up
+	b.mtx.Lock()
+	b.refill()
+	b.tokens--
+	wait := time.Duration(-b.tokens * float64(b.every))
+	b.mtx.Unlock()
+	if wait <= 0 {
+		return nil
+	}
+
+	ready := make(chan struct{})
+	timer := b.clk.AfterFunc(wait, func() { close(ready) })
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		timer.Stop()
+		// Give the token back
+		b.mtx.Lock()
+		b.tokens++
+		b.mtx.Unlock()
+		return ctx.Err()
+	}
+}
+
+// Tokens returns the number of events allowed right now.
+func (b *TokenBucketLimiter) Tokens() float64 {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.refill()
+	return b.tokens
+}
+
+// refill adds the tokens earned since the last refill.
+func (b *TokenBucketLimiter) refill() {
+	now := b.clk.Now()
+	if b.every > 0 {
+		b.tokens = min(b.burst, b.tokens+float64(now.Sub(b.last))/float64(b.every))
+	} else {
+		b.tokens = b.burst
+	}
+	b.last = now
+}
+
+var _ Limiter = (*TokenBucketLimiter)(nil)
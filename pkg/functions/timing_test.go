@@ -0,0 +1,140 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package functions
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDebounce_Trailing(t *testing.T) {
+	clk := newFakeClock()
+	var got []string
+	debounced := Debounce(context.Background(), DebounceConfig{Wait: 100 * time.Millisecond, Clk: clk}, func(s string) {
+		got = append(got, s)
+	})
+
+	debounced("a")
+	clk.Advance(50 * time.Millisecond)
+	debounced("b")
+	clk.Advance(50 * time.Millisecond)
+	debounced("c")
+	if len(got) != 0 {
+		t.Fatalf("Debounce() called the function during the burst: %v", got)
+	}
+	clk.Advance(100 * time.Millisecond)
+	debounced("d")
+	clk.Advance(100 * time.Millisecond)
+
+	if !reflect.DeepEqual(got, []string{"c", "d"}) {
+		t.Errorf("Debounce() called the function with %v, want [c d]", got)
+	}
+}
+
+func TestDebounce_Leading(t *testing.T) {
+	clk := newFakeClock()
+	var leading, both []int
+	l := Debounce(context.Background(), DebounceConfig{Wait: time.Second, Edge: Leading, Clk: clk}, func(v int) {
+		leading = append(leading, v)
+	})
+	b := Debounce(context.Background(), DebounceConfig{Wait: time.Second, Edge: LeadingAndTrailing, Clk: clk}, func(v int) {
+		both = append(both, v)
+	})
+
+	for i := 1; i <= 3; i++ {
+		l(i)
+		b(i)
+		clk.Advance(500 * time.Millisecond)
+	}
+	clk.Advance(time.Second)
+	// A single call in a burst isn't repeated on the trailing edge
+	b(4)
+	clk.Advance(time.Second)
+
+	if !reflect.DeepEqual(leading, []int{1}) {
+		t.Errorf("Debounce(Leading) called the function with %v, want [1]", leading)
+	}
+	if !reflect.DeepEqual(both, []int{1, 3, 4}) {
+		t.Errorf("Debounce(LeadingAndTrailing) called the function with %v, want [1 3 4]", both)
+	}
+}
+
+func TestDebounce_Cancel(t *testing.T) {
+	clk := newFakeClock()
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	debounced := Debounce(ctx, DebounceConfig{Wait: time.Second, Clk: clk}, func(int) { calls++ })
+
+	debounced(1)
+	cancel()
+	// The context stops the function from its own goroutine
+	time.Sleep(10 * time.Millisecond)
+	clk.Advance(time.Second)
+	debounced(2)
+	clk.Advance(time.Second)
+
+	if calls != 0 {
+		t.Errorf("Debounce() called the function %d times after the cancellation", calls)
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	clk := newFakeClock()
+	var got []int
+	throttled := Throttle(context.Background(), ThrottleConfig{Interval: time.Second, Clk: clk}, func(v int) {
+		got = append(got, v)
+	})
+
+	for i := 0; i < 10; i++ {
+		throttled(i)
+		clk.Advance(300 * time.Millisecond)
+	}
+	if !reflect.DeepEqual(got, []int{0, 4, 8}) {
+		t.Errorf("Throttle() called the function with %v, want [0 4 8]", got)
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	clk := newFakeClock()
+	bucket := TokenBucket(100*time.Millisecond, 2, clk)
+
+	if !bucket.Allow() || !bucket.Allow() || bucket.Allow() {
+		t.Fatalf("Allow() didn't allow exactly the burst")
+	}
+	clk.Advance(100 * time.Millisecond)
+	if !bucket.Allow() {
+		t.Errorf("Allow() didn't allow the refilled token")
+	}
+
+	calls := 0
+	limited := RateLimited(bucket, func(_ context.Context, v int) (int, error) {
+		calls++
+		return v, nil
+	})
+	done := make(chan error)
+	go func() {
+		_, err := limited(context.Background(), 1)
+		done <- err
+	}()
+
+	// The call waits for the next token
+	for i := 0; i < 100 && clk.Pending() == 0; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	if calls != 0 {
+		t.Fatalf("RateLimited() didn't wait for a token")
+	}
+	clk.Advance(100 * time.Millisecond)
+	if err := <-done; err != nil || calls != 1 {
+		t.Errorf("RateLimited() = %v after %d calls, want a single call", err, calls)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := limited(ctx, 2); !errors.Is(err, context.Canceled) {
+		t.Errorf("RateLimited() error = %v with a cancelled context, want context.Canceled", err)
+	}
+}
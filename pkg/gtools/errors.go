@@ -373,3 +373,97 @@ func (e *OperationalErrorImpl) Operation() string {
 }
 
 var _ error = (*OperationalErrorImpl)(nil)
+
+// NewIndexedError creates a new IndexedError for the element at the given index.
+func NewIndexedError(index int, err error) *IndexedError {
+	return &IndexedError{
+		Index: index,
+		Err:   err,
+	}
+}
+
+// IndexedError is an error raised while processing the element at Index of a collection.
+type IndexedError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexedError) Error() string {
+	return fmt.Sprintf("element %d: %v", e.Index, e.Err)
+}
+
+func (e *IndexedError) Unwrap() error {
+	return e.Err
+}
+
+var _ error = (*IndexedError)(nil)
+var _ WrappedError = (*IndexedError)(nil)
+
+// NewIndexedErrors creates an empty IndexedErrors.
+func NewIndexedErrors() *IndexedErrors {
+	return &IndexedErrors{}
+}
+
+// IndexedErrors collects the errors raised while processing several elements of a collection.
+// Unlike the stackable errors, it doesn't flatten the errors, so every error keeps its index,
+// and errors.Is and errors.As find both the IndexedErrors and the errors they wrap.
+// This isn't Thread-Safe.
+type IndexedErrors struct {
+	errs []*IndexedError
+}
+
+// Add collects the error of the element at the given index. A nil error is ignored.
+func (e *IndexedErrors) Add(index int, err error) {
+	if err != nil {
+		e.errs = append(e.errs, NewIndexedError(index, err))
+	}
+}
+
+// Errors returns the collected errors, in the order they were added.
+func (e *IndexedErrors) Errors() []*IndexedError {
+	return e.errs
+}
+
+// Indexes returns the indexes of the collected errors, in the order they were added.
+func (e *IndexedErrors) Indexes() []int {
+	indexes := make([]int, len(e.errs))
+	for i, err := range e.errs {
+		indexes[i] = err.Index
+	}
+	return indexes
+}
+
+func (e *IndexedErrors) Len() int {
+	return len(e.errs)
+}
+
+// OrNil returns the IndexedErrors, or nil if no error was collected.
+func (e *IndexedErrors) OrNil() error {
+	if len(e.errs) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *IndexedErrors) Error() string {
+	msgs := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e *IndexedErrors) Trace() string {
+	return ReadTrace(e.Unwrap())
+}
+
+// Unwrap returns the collected errors as IndexedErrors.
+func (e *IndexedErrors) Unwrap() []error {
+	errs := make([]error, len(e.errs))
+	for i, err := range e.errs {
+		errs[i] = err
+	}
+	return errs
+}
+
+var _ StackableError = (*IndexedErrors)(nil)
@@ -106,3 +106,30 @@ func TestConcurrentStackableError_Trace(t *testing.T) {
 		t.Fatal("expected non-empty trace after stacking errors")
 	}
 }
+
+func TestIndexedErrors(t *testing.T) {
+	notFound := errors.New("not found")
+	errs := NewIndexedErrors()
+	if errs.OrNil() != nil {
+		t.Fatalf("expected nil without errors")
+	}
+
+	errs.Add(1, fmt.Errorf("bad 1"))
+	errs.Add(2, nil)
+	errs.Add(3, fmt.Errorf("lookup: %w", notFound))
+	err := errs.OrNil()
+
+	if errs.Len() != 2 || fmt.Sprint(errs.Indexes()) != "[1 3]" {
+		t.Errorf("expected the indexes [1 3], got %v", errs.Indexes())
+	}
+	if err.Error() != "element 1: bad 1\nelement 3: lookup: not found" {
+		t.Errorf("unexpected message: %q", err.Error())
+	}
+	var ie *IndexedError
+	if !errors.As(err, &ie) || ie.Index != 1 || !errors.Is(err, notFound) {
+		t.Errorf("expected errors.As and errors.Is to look into the collected errors")
+	}
+	if trace := errs.Trace(); trace != "Error trace:\n 1: element 1: bad 1\n 2: element 3: lookup: not found\n" {
+		t.Errorf("unexpected trace: %q", trace)
+	}
+}
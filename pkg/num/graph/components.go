@@ -0,0 +1,75 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package graph
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/constraints"
+	"github.com/andrerrcosta2/gtools/pkg/datastr/disjoint"
+	"github.com/andrerrcosta2/gtools/pkg/gtools"
+	"sort"
+)
+
+// ComponentsOf returns the connected components of the graph.
+// Edges are considered regardless of their direction, so for directed graphs
+// the result is the set of weakly connected components.
+//
+// Parameters:
+// - g: The graph to partition.
+//
+// Returns:
+// - [][]G: The nodes of the graph grouped by component.
+func ComponentsOf[G gtools.SortableOf](g Graph[G]) [][]G {
+	return components(g).Groups()
+}
+
+// CountComponentsOf returns the number of connected components of the graph.
+// Edges are considered regardless of their direction.
+func CountComponentsOf[G gtools.SortableOf](g Graph[G]) int {
+	return components(g).Count()
+}
+
+// components builds a disjoint-set forest where every edge of the graph joins the sets of its endpoints.
+func components[G gtools.SortableOf](g Graph[G]) *disjoint.SortableOfForest[G] {
+	nodes := g.Nodes()
+	// Every node starts as its own component
+	forest := disjoint.SortableOf(nodes...)
+
+	// Each edge merges the components of its endpoints
+	for _, node := range nodes {
+		for _, neighbor := range g.Neighbors(node) {
+			forest.Union(node, neighbor)
+		}
+	}
+	return forest
+}
+
+// KruskalOf returns the minimum spanning forest of the weighted graph using Kruskal's algorithm.
+// Edges are considered regardless of their direction and are picked by increasing weight,
+// skipping the ones that would close a cycle.
+//
+// Complexity: O(E log(E)) for sorting the edges, the union-find operations are nearly constant.
+//
+// Parameters:
+// - g: The weighted graph.
+//
+// Returns:
+// - []*SingleTypedWeightedEdge[G, W]: The edges of the minimum spanning forest.
+func KruskalOf[G gtools.SortableOf, W constraints.Ordered](g WOrderedGraphOf[G, W]) []*SingleTypedWeightedEdge[G, W] {
+	edges := g.Edges()
+
+	// Sort the edges by weight, keeping the deterministic order of Edges() on ties
+	sort.SliceStable(edges, func(i, j int) bool {
+		return edges[i].Weight() < edges[j].Weight()
+	})
+
+	forest := disjoint.SortableOf(g.Nodes()...)
+	tree := make([]*SingleTypedWeightedEdge[G, W], 0, len(edges))
+
+	for _, edge := range edges {
+		// Union returns false when both endpoints are already connected
+		if forest.Union(edge.From(), edge.To()) {
+			tree = append(tree, edge)
+		}
+	}
+	return tree
+}
@@ -0,0 +1,71 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package graph
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/testdata/testsortables"
+	"testing"
+)
+
+func TestComponentsOf(t *testing.T) {
+	g := UndirectOf[testsortables.TestNode]()
+	for _, n := range []string{"A", "B", "C", "D", "E", "F"} {
+		g.AddNode(testsortables.TestNode(n))
+	}
+	g.AddEdge("A", "B")
+	g.AddEdge("B", "C")
+	g.AddEdge("D", "E")
+
+	if got := CountComponentsOf[testsortables.TestNode](g); got != 3 {
+		t.Errorf("CountComponentsOf() = %d, want 3", got)
+	}
+
+	sizes := map[int]int{}
+	for _, c := range ComponentsOf[testsortables.TestNode](g) {
+		sizes[len(c)]++
+	}
+	if sizes[3] != 1 || sizes[2] != 1 || sizes[1] != 1 {
+		t.Errorf("ComponentsOf() returned components of sizes %v", sizes)
+	}
+}
+
+func TestComponentsOf_DirectedGraph(t *testing.T) {
+	g := DigraphOf[testsortables.TestNode]()
+	for _, n := range []string{"A", "B", "C"} {
+		g.AddNode(testsortables.TestNode(n))
+	}
+	// A and C only share an incoming edge, which still makes them weakly connected
+	g.AddEdge("A", "B")
+	g.AddEdge("C", "B")
+
+	if got := CountComponentsOf[testsortables.TestNode](g); got != 1 {
+		t.Errorf("CountComponentsOf() = %d, want 1", got)
+	}
+}
+
+func TestKruskalOf(t *testing.T) {
+	g := WeightedOrderedOf[testsortables.TestNode, int]()
+	for _, n := range []string{"A", "B", "C", "D", "E"} {
+		g.AddNode(testsortables.TestNode(n))
+	}
+	g.AddEdge("A", "B", 4)
+	g.AddEdge("A", "C", 1)
+	g.AddEdge("B", "C", 2)
+	g.AddEdge("B", "D", 5)
+	g.AddEdge("C", "D", 8)
+	g.AddEdge("D", "E", 3)
+
+	tree := KruskalOf[testsortables.TestNode, int](g)
+	if len(tree) != 4 {
+		t.Fatalf("KruskalOf() returned %d edges, want 4", len(tree))
+	}
+
+	total := 0
+	for _, e := range tree {
+		total += e.Weight()
+	}
+	// A-C (1) + B-C (2) + D-E (3) + B-D (5)
+	if total != 11 {
+		t.Errorf("KruskalOf() total weight = %d, want 11", total)
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/andrerrcosta2/gtools/pkg/datastr/maps"
 	"github.com/andrerrcosta2/gtools/pkg/datastr/sets"
 	"github.com/andrerrcosta2/gtools/pkg/gtools"
+	"iter"
+	"slices"
 )
 
 // DigraphOf creates a new DirectedGraphOf instance.
@@ -101,6 +103,21 @@ func (g *DirectedGraphOf[G]) Edges() []*SingleTypedEdge[G] {
 	return edges.Values()
 }
 
+// All returns a sequence of the nodes of the graph and their outgoing neighbors, in no particular order.
+func (g *DirectedGraphOf[G]) All() iter.Seq2[G, []G] {
+	return g.adj.All()
+}
+
+// NodesSeq returns a sequence of the nodes of the graph, in no particular order.
+func (g *DirectedGraphOf[G]) NodesSeq() iter.Seq[G] {
+	return g.adj.KeysSeq()
+}
+
+// EdgesSeq returns a sequence of the edges of the graph, in the same order as Edges.
+func (g *DirectedGraphOf[G]) EdgesSeq() iter.Seq[*SingleTypedEdge[G]] {
+	return slices.Values(g.Edges())
+}
+
 // String returns a string representation of the graph.
 func (g *DirectedGraphOf[G]) String() string {
 	return g.adj.String()
@@ -166,3 +166,36 @@ func TestDirectedGraph_AddEdgeNodesNotExist(t *testing.T) {
 		t.Errorf("Edge from A to B should not be present")
 	}
 }
+
+// TestDirectedGraph_All tests ranging over the adjacency of the graph.
+func TestDirectedGraph_All(t *testing.T) {
+	graph := DigraphOf[testsortables.TestNode]()
+	a, b, c := testsortables.TestNode("a"), testsortables.TestNode("b"), testsortables.TestNode("c")
+	graph.AddNode(a)
+	graph.AddNode(b)
+	graph.AddNode(c)
+	graph.AddEdge(a, b)
+	graph.AddEdge(a, c)
+
+	degree := 0
+	for node, neighbors := range graph.All() {
+		if node.Equal(a) {
+			degree = len(neighbors)
+		}
+	}
+	if degree != 2 {
+		t.Errorf("All() yielded %d neighbors for a, want 2", degree)
+	}
+
+	nodes := 0
+	for range graph.NodesSeq() {
+		nodes++
+	}
+	edges := 0
+	for range graph.EdgesSeq() {
+		edges++
+	}
+	if nodes != 3 || edges != 2 {
+		t.Errorf("NodesSeq() and EdgesSeq() yielded %d nodes and %d edges, want 3 and 2", nodes, edges)
+	}
+}
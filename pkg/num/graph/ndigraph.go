@@ -8,6 +8,8 @@ import (
 	"github.com/andrerrcosta2/gtools/pkg/datastr/sets"
 	"github.com/andrerrcosta2/gtools/pkg/gtools"
 	"github.com/andrerrcosta2/gtools/pkg/sortables"
+	"iter"
+	"slices"
 )
 
 // UndirectOf creates a new UndirectGraphOf instance.
@@ -138,6 +140,21 @@ func (g *UndirectGraphOf[G]) Edges() []*SingleTypedEdge[G] {
 	return edges.Values()
 }
 
+// All returns a sequence of the nodes of the graph and their adjacent neighbors, in no particular order.
+func (g *UndirectGraphOf[G]) All() iter.Seq2[G, []G] {
+	return g.adj.All()
+}
+
+// NodesSeq returns a sequence of the nodes of the graph, in no particular order.
+func (g *UndirectGraphOf[G]) NodesSeq() iter.Seq[G] {
+	return g.adj.KeysSeq()
+}
+
+// EdgesSeq returns a sequence of the edges of the graph, in the same order as Edges.
+func (g *UndirectGraphOf[G]) EdgesSeq() iter.Seq[*SingleTypedEdge[G]] {
+	return slices.Values(g.Edges())
+}
+
 // String returns a string representation of the graph.
 func (g *UndirectGraphOf[G]) String() string {
 	return g.adj.String()
@@ -7,6 +7,8 @@ import (
 	"github.com/andrerrcosta2/gtools/pkg/datastr/maps"
 	"github.com/andrerrcosta2/gtools/pkg/datastr/sets"
 	"github.com/andrerrcosta2/gtools/pkg/gtools"
+	"iter"
+	"slices"
 )
 
 // WeightedOrderedOf returns a new instance of WeightedOrderedGraphOf.
@@ -101,6 +103,27 @@ func (g *WeightedOrderedGraphOf[G, W]) Edges() []*SingleTypedWeightedEdge[G, W]
 	return edges.Values()
 }
 
+// All returns a sequence of the nodes of the graph and their outgoing neighbors, in no particular order.
+func (g *WeightedOrderedGraphOf[G, W]) All() iter.Seq2[G, []G] {
+	return func(yield func(G, []G) bool) {
+		for node, neighbors := range g.adj.All() {
+			if !yield(node, neighbors.Keys()) {
+				return
+			}
+		}
+	}
+}
+
+// NodesSeq returns a sequence of the nodes of the graph, in no particular order.
+func (g *WeightedOrderedGraphOf[G, W]) NodesSeq() iter.Seq[G] {
+	return g.adj.KeysSeq()
+}
+
+// EdgesSeq returns a sequence of the edges of the graph, in the same order as Edges.
+func (g *WeightedOrderedGraphOf[G, W]) EdgesSeq() iter.Seq[*SingleTypedWeightedEdge[G, W]] {
+	return slices.Values(g.Edges())
+}
+
 func (g *WeightedOrderedGraphOf[G, W]) String() string {
 	return g.adj.String()
 }
@@ -0,0 +1,58 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package progression
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/constraints"
+	"github.com/andrerrcosta2/gtools/pkg/num/polyn"
+	"iter"
+)
+
+// The functions below are the lazy counterparts of the progressions of this package.
+// They return infinite sequences, computed on demand, which must be bounded by the
+// consumer, for example by breaking out of the range loop or with pipe.Stream.Take.
+
+// SequenceSeq returns the sequence 0, 1, 2, ...
+func SequenceSeq[T constraints.Numeric]() iter.Seq[T] {
+	return ArithmeticSeq[T](0, 1)
+}
+
+// ArithmeticSeq returns the arithmetic progression with a given starting value and step size.
+//
+// f(n) = start + step * n
+func ArithmeticSeq[T constraints.Numeric](start T, step T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := 0; yield(polyn.Linear(start, step, n)); n++ {
+		}
+	}
+}
+
+// GeometricSeq returns the geometric progression with a given starting value and ratio.
+//
+// f(n) = start * ratio^n
+func GeometricSeq[T constraints.Numeric](start T, ratio T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := start; yield(v); v = T(float64(v) * float64(ratio)) {
+		}
+	}
+}
+
+// FibonacciSeq returns the Fibonacci sequence.
+//
+// f(n) = f(n-1) + f(n-2)
+func FibonacciSeq[T constraints.Numeric]() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for a, b := T(0), T(1); yield(a); a, b = b, a+b {
+		}
+	}
+}
+
+// PolynomialSeq returns the polynomial progression with given coefficients.
+//
+// f(n) = c0 + c1*n + c2*n^2 + ... + ck*n^k
+func PolynomialSeq[T constraints.Numeric](coefficients ...T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := 0; yield(polyn.Polynomial(n, coefficients...)); n++ {
+		}
+	}
+}
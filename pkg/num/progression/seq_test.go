@@ -0,0 +1,101 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package progression
+
+import (
+	"iter"
+	"reflect"
+	"testing"
+)
+
+// take returns the first n values of the sequence, breaking out of the range loop.
+func take[T any](seq iter.Seq[T], n int) []T {
+	values := make([]T, 0, n)
+	if n <= 0 {
+		return values
+	}
+	for v := range seq {
+		values = append(values, v)
+		if len(values) == n {
+			break
+		}
+	}
+	return values
+}
+
+func TestSeq_Values(t *testing.T) {
+	tests := []struct {
+		name string
+		got  []float64
+		want []float64
+	}{
+		{"SequenceSeq", take(SequenceSeq[float64](), 5), []float64{0, 1, 2, 3, 4}},
+		{"ArithmeticSeq", take(ArithmeticSeq(2.0, 3), 5), []float64{2, 5, 8, 11, 14}},
+		{"ArithmeticSeq with a negative step", take(ArithmeticSeq(1.0, -0.5), 4), []float64{1, 0.5, 0, -0.5}},
+		{"GeometricSeq", take(GeometricSeq(3.0, 2), 5), []float64{3, 6, 12, 24, 48}},
+		{"GeometricSeq with a fractional ratio", take(GeometricSeq(8.0, 0.5), 4), []float64{8, 4, 2, 1}},
+		{"FibonacciSeq", take(FibonacciSeq[float64](), 8), []float64{0, 1, 1, 2, 3, 5, 8, 13}},
+		{"PolynomialSeq", take(PolynomialSeq(1.0, 2, 3), 4), []float64{1, 6, 17, 34}},
+		{"PolynomialSeq without coefficients", take(PolynomialSeq[float64](), 3), []float64{0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeq_MatchesTheEagerProgressions(t *testing.T) {
+	for _, n := range []int{0, 1, 10} {
+		if got, want := take(SequenceSeq[int](), n), Sequence[int](n); !reflect.DeepEqual(got, want) {
+			t.Errorf("SequenceSeq() took %v, want %v", got, want)
+		}
+		if got, want := take(ArithmeticSeq(4, 7), n), Arithmetic(4, 7, n); !reflect.DeepEqual(got, want) {
+			t.Errorf("ArithmeticSeq() took %v, want %v", got, want)
+		}
+		if got, want := take(GeometricSeq(1, 3), n), Geometric(1, 3, n); !reflect.DeepEqual(got, want) {
+			t.Errorf("GeometricSeq() took %v, want %v", got, want)
+		}
+		if got, want := take(PolynomialSeq(2, 0, 1), n), Polynomial(n, 2, 0, 1); !reflect.DeepEqual(got, want) {
+			t.Errorf("PolynomialSeq() took %v, want %v", got, want)
+		}
+	}
+	if got, want := take(FibonacciSeq[int](), 10), Fibonacci[int](10); !reflect.DeepEqual(got, want) {
+		t.Errorf("FibonacciSeq() took %v, want %v", got, want)
+	}
+}
+
+func TestSeq_StopsWhenTheConsumerStops(t *testing.T) {
+	seqs := map[string]iter.Seq[int]{
+		"SequenceSeq":   SequenceSeq[int](),
+		"ArithmeticSeq": ArithmeticSeq(0, 2),
+		"GeometricSeq":  GeometricSeq(1, 2),
+		"FibonacciSeq":  FibonacciSeq[int](),
+		"PolynomialSeq": PolynomialSeq(0, 0, 1),
+	}
+	for name, seq := range seqs {
+		t.Run(name, func(t *testing.T) {
+			// Returning false from yield must end the generator at once
+			calls := 0
+			seq(func(int) bool {
+				calls++
+				return calls < 3
+			})
+			if calls != 3 {
+				t.Errorf("the generator called yield %d times, want 3", calls)
+			}
+
+			// Breaking out of the range loop on the first value
+			count := 0
+			for range seq {
+				count++
+				break
+			}
+			if count != 1 {
+				t.Errorf("the range loop ran %d times, want 1", count)
+			}
+		})
+	}
+}
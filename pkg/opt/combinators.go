@@ -0,0 +1,77 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package opt
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"github.com/andrerrcosta2/gtools/pkg/tuple"
+)
+
+// The functions below transform Options. They're functions instead of methods
+// because Go methods can't declare type parameters of their own.
+
+// Map returns an Option with the result of f applied to the value of the Option,
+// or a None Option if it isn't set.
+//
+// Parameters:
+// - o: The Option to transform.
+// - f: The function applied to the value.
+//
+// Returns:
+// - A pointer to the new Option.
+func Map[T any, R any](o *Option[T], f functions.Function[T, R]) *Option[R] {
+	if !o.IsPresent() {
+		return None[R]()
+	}
+	return Of(f(*o.value))
+}
+
+// FlatMap returns the Option returned by f applied to the value of the Option,
+// or a None Option if it isn't set.
+//
+// Parameters:
+// - o: The Option to transform.
+// - f: The function applied to the value.
+//
+// Returns:
+// - A pointer to the Option returned by f, or to a None Option.
+func FlatMap[T any, R any](o *Option[T], f functions.Function[T, *Option[R]]) *Option[R] {
+	if !o.IsPresent() {
+		return None[R]()
+	}
+	return f(*o.value)
+}
+
+// Filter returns the Option if it is set and its value satisfies the predicate, or a None Option.
+func Filter[T any](o *Option[T], predicate functions.Predicate[T]) *Option[T] {
+	if !o.IsPresent() || !predicate(*o.value) {
+		return None[T]()
+	}
+	return o
+}
+
+// Zip returns an Option with the pair of the values of both Options if both are set, or a None Option.
+func Zip[A any, B any](a *Option[A], b *Option[B]) *Option[*tuple.Pair[A, B]] {
+	if !a.IsPresent() || !b.IsPresent() {
+		return None[*tuple.Pair[A, B]]()
+	}
+	return Of(tuple.NewPair(*a.value, *b.value))
+}
+
+// Or returns the Option if it is set, otherwise it returns the alternative Option.
+func Or[T any](o *Option[T], alternative *Option[T]) *Option[T] {
+	if o.IsPresent() {
+		return o
+	}
+	return alternative
+}
+
+// FirstPresent returns the first Option that is set, or a None Option if none of them is.
+func FirstPresent[T any](options ...*Option[T]) *Option[T] {
+	for _, o := range options {
+		if o.IsPresent() {
+			return o
+		}
+	}
+	return None[T]()
+}
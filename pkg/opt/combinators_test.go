@@ -0,0 +1,71 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package opt
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestMapAndFlatMap(t *testing.T) {
+	length := Map(Of("gopher"), func(s string) int { return len(s) })
+	if !length.IsPresent() || length.Get() != 6 {
+		t.Errorf("Map() = %v, want 6", length.ToSlice())
+	}
+	if Map(None[string](), func(s string) int { return len(s) }).IsPresent() {
+		t.Errorf("Map() of a None Option is present")
+	}
+
+	parse := func(s string) *Option[int] {
+		v, err := strconv.Atoi(s)
+		if err != nil {
+			return None[int]()
+		}
+		return Of(v)
+	}
+	if FlatMap(Of("x"), parse).IsPresent() || FlatMap(Of("12"), parse).Get() != 12 {
+		t.Errorf("FlatMap() didn't return the Option of the function")
+	}
+}
+
+func TestFilterZipOr(t *testing.T) {
+	positive := func(v int) bool { return v > 0 }
+	if Filter(Of(-1), positive).IsPresent() || !Filter(Of(1), positive).IsPresent() {
+		t.Errorf("Filter() didn't apply the predicate")
+	}
+
+	pair := Zip(Of("port"), Of(8080))
+	if !pair.IsPresent() || pair.Get().First() != "port" || pair.Get().Second() != 8080 {
+		t.Errorf("Zip() didn't pair the values")
+	}
+	if Zip(Of("port"), None[int]()).IsPresent() {
+		t.Errorf("Zip() with a None Option is present")
+	}
+
+	if Or(None[int](), Of(2)).Get() != 2 || Or(Of(1), Of(2)).Get() != 1 {
+		t.Errorf("Or() didn't return the first present Option")
+	}
+	if FirstPresent(None[int](), None[int](), Of(3), Of(4)).Get() != 3 || FirstPresent[int]().IsPresent() {
+		t.Errorf("FirstPresent() didn't return the first present Option")
+	}
+}
+
+func TestOrElseFuncAndIfPresentOrElse(t *testing.T) {
+	calls := 0
+	supplier := func() int { calls++; return 5 }
+	if Of(1).OrElseFunc(supplier) != 1 || None[int]().OrElseFunc(supplier) != 5 || calls != 1 {
+		t.Errorf("OrElseFunc() called the supplier %d times, want 1", calls)
+	}
+
+	var got []string
+	Of("a").IfPresentOrElse(func(s string) { got = append(got, s) }, func() { got = append(got, "none") })
+	None[string]().IfPresentOrElse(func(s string) { got = append(got, s) }, func() { got = append(got, "none") })
+	if !reflect.DeepEqual(got, []string{"a", "none"}) {
+		t.Errorf("IfPresentOrElse() = %v, want [a none]", got)
+	}
+
+	if len(None[int]().ToSlice()) != 0 || !reflect.DeepEqual(Of(1).ToSlice(), []int{1}) {
+		t.Errorf("ToSlice() didn't return the value of the Option")
+	}
+}
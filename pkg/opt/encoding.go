@@ -0,0 +1,117 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package opt
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"reflect"
+)
+
+// The methods below let an Option be used directly in DTO structs and database rows.
+// The marshalling methods have value receivers, so they work on Option and *Option fields.
+//
+// An absent value is encoded as JSON null and SQL NULL. To omit an absent value from a JSON
+// object instead, declare the field as a *Option tagged with `json:",omitempty"` and leave it nil.
+
+// MarshalJSON encodes the value of the Option, or null if it isn't set.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.isSet {
+		return []byte("null"), nil
+	}
+	return json.Marshal(*o.value)
+}
+
+// UnmarshalJSON decodes the value of the Option. A null unsets the Option.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		o.Unset()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	o.Set(value)
+	return nil
+}
+
+// IsZero checks if the Option isn't set.
+func (o Option[T]) IsZero() bool {
+	return !o.isSet
+}
+
+// MarshalText encodes the value of the Option as text, or as an empty text if it isn't set.
+// The value is encoded with its own MarshalText method if it has one, as is if it's a string,
+// and as JSON otherwise, which suits numbers and booleans.
+//
+// For a string T, an empty text is a present empty string, so Of("") keeps its value through
+// MarshalText and UnmarshalText, but an unset Option reads back as Of(""). Use JSON to keep
+// the absence of a string.
+func (o Option[T]) MarshalText() ([]byte, error) {
+	if !o.isSet {
+		return []byte{}, nil
+	}
+	if m, ok := any(*o.value).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	if v := reflect.ValueOf(*o.value); v.Kind() == reflect.String {
+		return []byte(v.String()), nil
+	}
+	return json.Marshal(*o.value)
+}
+
+// UnmarshalText decodes the value of the Option from the text MarshalText produces.
+// An empty text unsets the Option, unless T is a string, whose empty value it sets.
+func (o *Option[T]) UnmarshalText(text []byte) error {
+	var value T
+	u, isText := any(&value).(encoding.TextUnmarshaler)
+	isString := reflect.ValueOf(&value).Elem().Kind() == reflect.String
+	if len(text) == 0 && (isText || !isString) {
+		o.Unset()
+		return nil
+	}
+	if isText {
+		if err := u.UnmarshalText(text); err != nil {
+			return err
+		}
+	} else if isString {
+		reflect.ValueOf(&value).Elem().SetString(string(text))
+	} else if err := json.Unmarshal(text, &value); err != nil {
+		return err
+	}
+	o.Set(value)
+	return nil
+}
+
+// Scan reads the value of the Option from a database column. NULL unsets the Option.
+func (o *Option[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	if !n.Valid {
+		o.Unset()
+		return nil
+	}
+	o.Set(n.V)
+	return nil
+}
+
+// Value returns the value of the Option for a database column, or NULL if it isn't set.
+func (o Option[T]) Value() (driver.Value, error) {
+	if !o.isSet {
+		return nil, nil
+	}
+	return sql.Null[T]{V: *o.value, Valid: true}.Value()
+}
+
+var _ json.Marshaler = Option[int]{}
+var _ json.Unmarshaler = (*Option[int])(nil)
+var _ encoding.TextMarshaler = Option[int]{}
+var _ encoding.TextUnmarshaler = (*Option[int])(nil)
+var _ sql.Scanner = (*Option[int])(nil)
+var _ driver.Valuer = Option[int]{}
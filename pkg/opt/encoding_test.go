@@ -0,0 +1,113 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package opt
+
+import (
+	"encoding/json"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+type user struct {
+	Name  string           `json:"name"`
+	Email Option[string]   `json:"email"`
+	Age   *Option[int]     `json:"age,omitempty"`
+	Nick  Option[string]   `json:"nick"`
+	Tags  Option[[]string] `json:"tags"`
+}
+
+func TestOption_JSON(t *testing.T) {
+	u := user{Name: "ana", Email: *Of("ana@example.com"), Tags: *None[[]string]()}
+	data, err := json.Marshal(u)
+	if err != nil {
+		t.Fatalf("Marshal() returned an error: %v", err)
+	}
+	if want := `{"name":"ana","email":"ana@example.com","nick":null,"tags":null}`; string(data) != want {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var loaded user
+	if err = json.Unmarshal([]byte(`{"name":"bo","email":null,"age":30,"nick":"b"}`), &loaded); err != nil {
+		t.Fatalf("Unmarshal() returned an error: %v", err)
+	}
+	if loaded.Email.IsPresent() || loaded.Age.Get() != 30 || loaded.Nick.Get() != "b" || loaded.Tags.IsPresent() {
+		t.Errorf("Unmarshal() = %+v", loaded)
+	}
+	if err = json.Unmarshal([]byte(`{"age":"x"}`), &loaded); err == nil {
+		t.Errorf("Unmarshal() accepted a string for an Option[int]")
+	}
+}
+
+func TestOption_Text(t *testing.T) {
+	addr := Of(netip.MustParseAddr("10.0.0.1"))
+	text, err := addr.MarshalText()
+	if err != nil || string(text) != "10.0.0.1" {
+		t.Errorf("MarshalText() = %s, %v, want 10.0.0.1", text, err)
+	}
+
+	m := map[string]Option[int]{"a": *Of(1), "b": *None[int]()}
+	data, _ := json.Marshal(m)
+	if string(data) != `{"a":1,"b":null}` {
+		t.Errorf("Marshal() = %s", data)
+	}
+
+	var port Option[int]
+	if err = port.UnmarshalText([]byte("8080")); err != nil || port.Get() != 8080 {
+		t.Errorf("UnmarshalText() = %v, %v, want 8080", port.OrElseGet(0), err)
+	}
+	var name Option[string]
+	if err = name.UnmarshalText([]byte("plain text")); err != nil || name.Get() != "plain text" {
+		t.Errorf("UnmarshalText() = %v, %v, want plain text", name.OrElseGet(""), err)
+	}
+	if err = port.UnmarshalText(nil); err != nil || port.IsPresent() {
+		t.Errorf("UnmarshalText() of an empty text didn't unset the Option")
+	}
+	if err = addr.UnmarshalText(nil); err != nil || addr.IsPresent() {
+		t.Errorf("UnmarshalText() of an empty text didn't unset the Option")
+	}
+}
+
+func TestOption_TextEmptyString(t *testing.T) {
+	text, err := Of("").MarshalText()
+	if err != nil || len(text) != 0 {
+		t.Fatalf("MarshalText() = %q, %v, want an empty text", text, err)
+	}
+	name := *Of("ana")
+	if err = name.UnmarshalText(text); err != nil || !name.IsPresent() || name.Get() != "" {
+		t.Errorf("UnmarshalText() didn't keep the empty string of Of(\"\")")
+	}
+}
+
+func TestOption_SQL(t *testing.T) {
+	var count Option[int64]
+	if err := count.Scan(int64(3)); err != nil || count.Get() != 3 {
+		t.Errorf("Scan(3) = %v, %v", count.OrElseGet(0), err)
+	}
+	if err := count.Scan(nil); err != nil || count.IsPresent() {
+		t.Errorf("Scan(nil) didn't unset the Option")
+	}
+
+	var at Option[time.Time]
+	now := time.Now()
+	if err := at.Scan(now); err != nil || !at.Get().Equal(now) {
+		t.Errorf("Scan(time) = %v, %v", at.OrElseGet(time.Time{}), err)
+	}
+
+	if v, err := None[string]().Value(); v != nil || err != nil {
+		t.Errorf("Value() of a None Option = %v, %v, want nil", v, err)
+	}
+	if v, err := Of(int32(7)).Value(); v != int64(7) || err != nil {
+		t.Errorf("Value() = %v (%T), %v, want int64 7", v, v, err)
+	}
+}
+
+func TestOption_UnsetDropsTheValue(t *testing.T) {
+	o := Of(1).Unset()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Get() of an unset Option didn't panic")
+		}
+	}()
+	o.Get()
+}
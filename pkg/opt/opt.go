@@ -55,19 +55,20 @@ func (o *Option[T]) Set(value T) *Option[T] {
 // Returns:
 // - The value stored in the Option struct.
 func (o *Option[T]) Get() T {
-	if o.value == nil {
+	if !o.isSet {
 		panic("Optional is empty")
 	}
 	return *o.value
 }
 
-// Unset sets the IsSet field of the Option struct to false and returns a pointer to the modified Option struct.
+// Unset sets the IsSet field of the Option struct to false, drops its value and returns a pointer to the modified Option struct.
 // This function is used to unset the value of an Option.
 //
 // Returns:
 // - A pointer to the modified Option struct.
 func (o *Option[T]) Unset() *Option[T] {
 	o.isSet = false // Set the IsSet field to false
+	o.value = nil   // Drop the value, so it can't be read nor kept alive
 	return o        // Return a pointer to the modified Option struct
 }
 
@@ -200,3 +201,39 @@ func EmptyString(a, b string) string {
 	}
 	return a
 }
+
+// OrElseFunc returns the value of the Option if it is set, otherwise it returns the value returned by the supplier.
+// The supplier is only called when the Option isn't set.
+//
+// Parameters:
+// - supplier: The function returning the default value.
+//
+// Returns:
+// - The value of the Option if it is set, otherwise the value returned by the supplier.
+func (o *Option[T]) OrElseFunc(supplier functions.Supplier[T]) T {
+	if o.isSet {
+		return *o.value
+	}
+	return supplier()
+}
+
+// IfPresentOrElse calls the consumer with the value if the Option is present, otherwise it calls the runnable.
+//
+// Parameters:
+// - consumer: The function called with the value if the Option is present.
+// - runnable: The function called if the Option isn't present.
+func (o *Option[T]) IfPresentOrElse(consumer functions.Consumer[T], runnable functions.Runnable) {
+	if o.isSet {
+		consumer(*o.value)
+		return
+	}
+	runnable()
+}
+
+// ToSlice returns a slice with the value of the Option if it is set, or an empty slice.
+func (o *Option[T]) ToSlice() []T {
+	if o.isSet {
+		return []T{*o.value}
+	}
+	return []T{}
+}
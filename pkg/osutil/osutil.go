@@ -40,6 +40,35 @@ func ReadFile(mode fsutil.PathType, path string) ([]byte, error) {
 	return data, nil
 }
 
+// WriteFile writes data to a file at the specified path, creating it if needed or truncating it otherwise.
+// The path is resolved based on the provided mode, which can be either Relative or Root.
+// The parent directories aren't created, use MkdirAll for that.
+//
+// Args:
+//
+//	mode (fsutil.PathType): The type of path to resolve.
+//	path (string): The path to the file.
+//	data ([]byte): The contents to write.
+//	perm (fs.FileMode): The permissions used if the file is created.
+//
+// Returns:
+//
+//	error: An error if the file cannot be written, or nil if successful.
+func WriteFile(mode fsutil.PathType, path string, data []byte, perm fs.FileMode) error {
+	// Resolve the file path based on the provided mode
+	fp, err := fsutil.BuildPath(mode, path)
+	if err != nil {
+		// Return the error if the path cannot be resolved
+		return err
+	}
+
+	// Attempt to write the file at the resolved path
+	if err := os.WriteFile(fp, data, perm); err != nil {
+		return fmt.Errorf("error writing file '%s': %v", path, err)
+	}
+	return nil
+}
+
 // ReadFiles reads the contents of multiple files concurrently.
 //
 // Args:
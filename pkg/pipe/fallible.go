@@ -0,0 +1,125 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package pipe
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"github.com/andrerrcosta2/gtools/pkg/gtools"
+)
+
+// The functions below are the counterparts of Map, Filter, Reduce and FlatMap for functions
+// that can fail. The plain variants stop at the first error and return it in a
+// gtools.IndexedError with the index of the failing element, while the All variants
+// process every element and return the errors collected in a gtools.IndexedErrors.
+
+// MapErr calls f for each element in arr, stopping at the first error.
+func MapErr[T any, R any](arr []T, f functions.Function2[T, R, error]) ([]R, error) {
+	result := make([]R, len(arr))
+	for i, v := range arr {
+		r, err := f(v)
+		if err != nil {
+			return nil, gtools.NewIndexedError(i, err)
+		}
+		result[i] = r
+	}
+	return result, nil
+}
+
+// MapErrAll calls f for each element in arr, collecting the errors.
+//
+// Returns:
+// - []R: The results, in the order of arr, with the zero value of R for the failing elements.
+// - error: A gtools.IndexedErrors with the errors of the failing elements, or nil.
+func MapErrAll[T any, R any](arr []T, f functions.Function2[T, R, error]) ([]R, error) {
+	result := make([]R, len(arr))
+	errs := gtools.NewIndexedErrors()
+	for i, v := range arr {
+		r, err := f(v)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		result[i] = r
+	}
+	return result, errs.OrNil()
+}
+
+// FilterErr returns the subset of arr for which f returns true, stopping at the first error.
+func FilterErr[T any](arr []T, f functions.Function2[T, bool, error]) ([]T, error) {
+	result := make([]T, 0)
+	for i, v := range arr {
+		ok, err := f(v)
+		if err != nil {
+			return nil, gtools.NewIndexedError(i, err)
+		}
+		if ok {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// FilterErrAll returns the subset of arr for which f returns true, collecting the errors.
+// The failing elements are left out of the result.
+func FilterErrAll[T any](arr []T, f functions.Function2[T, bool, error]) ([]T, error) {
+	result := make([]T, 0)
+	errs := gtools.NewIndexedErrors()
+	for i, v := range arr {
+		ok, err := f(v)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		if ok {
+			result = append(result, v)
+		}
+	}
+	return result, errs.OrNil()
+}
+
+// ReduceErr calls f for each element in arr, stopping at the first error.
+// As every step depends on the previous one, there's no variant collecting the errors.
+//
+// Returns:
+// - R: The result, or the partial result before the failing element.
+// - error: A gtools.IndexedError with the first error, or nil.
+func ReduceErr[T any, R any](arr []T, init R, f functions.BiFunction2[R, T, R, error]) (R, error) {
+	result := init
+	for i, v := range arr {
+		r, err := f(result, v)
+		if err != nil {
+			return result, gtools.NewIndexedError(i, err)
+		}
+		result = r
+	}
+	return result, nil
+}
+
+// FlatMapErr concatenates the slices returned by f for each element in arr, stopping at the first error.
+func FlatMapErr[T any, R any](arr []T, f functions.Function2[T, []R, error]) ([]R, error) {
+	result := make([]R, 0)
+	for i, v := range arr {
+		r, err := f(v)
+		if err != nil {
+			return nil, gtools.NewIndexedError(i, err)
+		}
+		result = append(result, r...)
+	}
+	return result, nil
+}
+
+// FlatMapErrAll concatenates the slices returned by f for each element in arr, collecting the errors.
+// The failing elements add nothing to the result.
+func FlatMapErrAll[T any, R any](arr []T, f functions.Function2[T, []R, error]) ([]R, error) {
+	result := make([]R, 0)
+	errs := gtools.NewIndexedErrors()
+	for i, v := range arr {
+		r, err := f(v)
+		if err != nil {
+			errs.Add(i, err)
+			continue
+		}
+		result = append(result, r...)
+	}
+	return result, errs.OrNil()
+}
@@ -0,0 +1,78 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package pipe
+
+import (
+	"errors"
+	"github.com/andrerrcosta2/gtools/pkg/gtools"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestMapErr(t *testing.T) {
+	got, err := MapErr([]string{"1", "2", "3"}, strconv.Atoi)
+	if err != nil || !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("MapErr() = %v, %v, want [1 2 3]", got, err)
+	}
+
+	_, err = MapErr([]string{"1", "x", "y"}, strconv.Atoi)
+	var ie *gtools.IndexedError
+	if !errors.As(err, &ie) || ie.Index != 1 || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("MapErr() error = %v, want a syntax error at index 1", err)
+	}
+
+	got, err = MapErrAll([]string{"1", "x", "3", "y"}, strconv.Atoi)
+	errs, ok := err.(*gtools.IndexedErrors)
+	if !ok || !reflect.DeepEqual(errs.Indexes(), []int{1, 3}) || !reflect.DeepEqual(got, []int{1, 0, 3, 0}) {
+		t.Errorf("MapErrAll() = %v, %v, want [1 0 3 0] and errors at 1 and 3", got, err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) || !strings.Contains(errs.Trace(), "element 3") {
+		t.Errorf("MapErrAll() error doesn't keep the failing elements and their errors:\n%s", errs.Trace())
+	}
+}
+
+func TestFilterErr(t *testing.T) {
+	isEven := func(s string) (bool, error) {
+		n, err := strconv.Atoi(s)
+		return n%2 == 0, err
+	}
+
+	if got, err := FilterErr([]string{"1", "2", "4"}, isEven); err != nil || !reflect.DeepEqual(got, []string{"2", "4"}) {
+		t.Errorf("FilterErr() = %v, %v, want [2 4]", got, err)
+	}
+	if _, err := FilterErr([]string{"2", "?"}, isEven); err == nil {
+		t.Errorf("FilterErr() didn't return the error")
+	}
+	got, err := FilterErrAll([]string{"?", "2", "3", "!"}, isEven)
+	if !errors.Is(err, strconv.ErrSyntax) || !reflect.DeepEqual(got, []string{"2"}) {
+		t.Errorf("FilterErrAll() = %v, %v, want [2] and a syntax error", got, err)
+	}
+}
+
+func TestReduceAndFlatMapErr(t *testing.T) {
+	sum := func(acc int, s string) (int, error) {
+		n, err := strconv.Atoi(s)
+		return acc + n, err
+	}
+	if got, err := ReduceErr([]string{"1", "2", "x", "4"}, 0, sum); err == nil || got != 3 {
+		t.Errorf("ReduceErr() = %v, %v, want the partial result 3 and an error", got, err)
+	}
+
+	empty := errors.New("empty line")
+	split := func(s string) ([]string, error) {
+		if s == "" {
+			return nil, empty
+		}
+		return strings.Split(s, ","), nil
+	}
+	if got, err := FlatMapErr([]string{"a,b", "c"}, split); err != nil || !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("FlatMapErr() = %v, %v, want [a b c]", got, err)
+	}
+	got, err := FlatMapErrAll([]string{"a", "", "b,c"}, split)
+	var ie *gtools.IndexedError
+	if !errors.Is(err, empty) || !errors.As(err, &ie) || ie.Index != 1 || !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("FlatMapErrAll() = %v, %v, want [a b c] and the empty line at 1", got, err)
+	}
+}
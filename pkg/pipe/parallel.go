@@ -0,0 +1,166 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package pipe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/conc"
+	"github.com/andrerrcosta2/gtools/pkg/gtools"
+	"runtime"
+	"sync"
+)
+
+// ParallelConfig configures the ParallelMap, ParallelFilter and ParallelEach functions.
+type ParallelConfig struct {
+	// Lim is the maximum number of calls running at the same time.
+	// Zero or less means runtime.GOMAXPROCS(0).
+	Lim int
+	// Sem bounds the calls instead of Lim when it's set, so the bound can be shared
+	// by several pipelines. Acquiring it observes the context only if it has an
+	// AcqCtx(context.Context) error method, like conc.ChannelSemaphore.
+	Sem gtools.Semaphore
+	// All keeps processing the elements after an error, and returns every error
+	// in a gtools.IndexedErrors instead of the first one.
+	All bool
+}
+
+// ParallelMap calls f for each element in arr, running up to the configured limit of calls at the same time.
+//
+// The context passed to f is cancelled on the first error, unless cfg.All is set, and no more
+// elements are processed once it's done. A panic in f is recovered and reported as an error.
+//
+// Parameters:
+// - ctx: The context of the calls.
+// - cfg: The concurrency limit and the error mode.
+// - arr: The elements.
+// - f: The function to call for each element.
+//
+// Returns:
+// - []R: The results, in the order of arr. It's nil if an error occurred.
+// - error: A gtools.IndexedError with the first error, a gtools.IndexedErrors with every error if
+// cfg.All is set, or the context error if it's done before every element is processed. In All mode,
+// a context done after some errors is joined to the gtools.IndexedErrors with errors.Join.
+func ParallelMap[T any, R any](ctx context.Context, cfg ParallelConfig, arr []T, f func(context.Context, T) (R, error)) ([]R, error) {
+	result := make([]R, len(arr))
+	err := parallel(ctx, cfg, len(arr), func(ctx context.Context, i int) error {
+		r, err := f(ctx, arr[i])
+		if err == nil {
+			result[i] = r
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ParallelFilter returns the subset of arr for which f returns true, keeping the order of arr.
+// It calls f the same way ParallelMap does.
+func ParallelFilter[T any](ctx context.Context, cfg ParallelConfig, arr []T, f func(context.Context, T) (bool, error)) ([]T, error) {
+	keep, err := ParallelMap(ctx, cfg, arr, f)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]T, 0)
+	for i, v := range arr {
+		if keep[i] {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// ParallelEach calls f for each element in arr the same way ParallelMap does.
+func ParallelEach[T any](ctx context.Context, cfg ParallelConfig, arr []T, f func(context.Context, T) error) error {
+	return parallel(ctx, cfg, len(arr), func(ctx context.Context, i int) error {
+		return f(ctx, arr[i])
+	})
+}
+
+// parallel calls f with the indexes from 0 to n, bounded by the configuration.
+func parallel(ctx context.Context, cfg ParallelConfig, n int, f func(context.Context, int) error) error {
+	sem := cfg.Sem
+	if sem == nil {
+		lim := cfg.Lim
+		if lim <= 0 {
+			lim = runtime.GOMAXPROCS(0)
+		}
+		sem = conc.NewChannelSemaphore(lim)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var first error
+	errs := make([]error, n)
+
+	for i := 0; i < n && acquire(ctx, sem) == nil; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			defer sem.Rls()
+			err := call(ctx, i, f)
+			if err == nil {
+				return
+			}
+			errs[i] = err
+			if !cfg.All {
+				once.Do(func() {
+					first = gtools.NewIndexedError(i, err)
+					cancel()
+				})
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if first != nil {
+		return first
+	}
+	if !cfg.All {
+		return ctx.Err()
+	}
+	collected := gtools.NewIndexedErrors()
+	for i, err := range errs {
+		collected.Add(i, err)
+	}
+	if collected.Len() == 0 {
+		return ctx.Err()
+	}
+	if ctx.Err() != nil {
+		return errors.Join(collected, ctx.Err())
+	}
+	return collected
+}
+
+// acquire acquires the semaphore, giving up once the context is done.
+// A semaphore without an AcqCtx method is released at once if the context is done after acquiring it.
+func acquire(ctx context.Context, sem gtools.Semaphore) error {
+	if s, ok := sem.(interface{ AcqCtx(context.Context) error }); ok {
+		if err := s.AcqCtx(ctx); err != nil {
+			return err
+		}
+	} else {
+		sem.Acq()
+	}
+	if err := ctx.Err(); err != nil {
+		sem.Rls()
+		return err
+	}
+	return nil
+}
+
+// call calls f, converting a panic into an error.
+func call(ctx context.Context, i int, f func(context.Context, int) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered from panic: %v", r)
+		}
+	}()
+	return f(ctx, i)
+}
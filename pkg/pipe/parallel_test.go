@@ -0,0 +1,151 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package pipe
+
+import (
+	"context"
+	"errors"
+	"github.com/andrerrcosta2/gtools/pkg/conc"
+	"github.com/andrerrcosta2/gtools/pkg/gtools"
+	"github.com/andrerrcosta2/gtools/pkg/num/progression"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelMap_OrderAndLimit(t *testing.T) {
+	var running, peak int32
+	ids := progression.Sequence[int](50)
+
+	got, err := ParallelMap(context.Background(), ParallelConfig{Lim: 4}, ids, func(_ context.Context, v int) (int, error) {
+		n := atomic.AddInt32(&running, 1)
+		for p := atomic.LoadInt32(&peak); n > p && !atomic.CompareAndSwapInt32(&peak, p, n); p = atomic.LoadInt32(&peak) {
+		}
+		time.Sleep(time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return v * v, nil
+	})
+
+	if err != nil {
+		t.Fatalf("ParallelMap() returned an error: %v", err)
+	}
+	for i, v := range got {
+		if v != i*i {
+			t.Fatalf("ParallelMap()[%d] = %d, want %d", i, v, i*i)
+		}
+	}
+	if peak > 4 {
+		t.Errorf("%d calls ran at the same time, want at most 4", peak)
+	}
+}
+
+func TestParallelMap_FirstError(t *testing.T) {
+	boom := errors.New("boom")
+	var calls int32
+
+	_, err := ParallelMap(context.Background(), ParallelConfig{Sem: conc.NewChannelSemaphore(2)}, progression.Sequence[int](100), func(ctx context.Context, v int) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		if v == 3 {
+			return 0, boom
+		}
+		select {
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		case <-time.After(5 * time.Millisecond):
+			return v, nil
+		}
+	})
+
+	var ie *gtools.IndexedError
+	if !errors.As(err, &ie) || ie.Index != 3 || !errors.Is(err, boom) {
+		t.Fatalf("ParallelMap() error = %v, want boom at index 3", err)
+	}
+	if calls == 100 {
+		t.Errorf("ParallelMap() didn't stop after the error")
+	}
+}
+
+func TestParallelEach_CollectAllAndPanics(t *testing.T) {
+	err := ParallelEach(context.Background(), ParallelConfig{Lim: 3, All: true}, progression.Sequence[int](10), func(_ context.Context, v int) error {
+		switch v {
+		case 2:
+			return errors.New("bad input")
+		case 7:
+			panic("unexpected")
+		}
+		return nil
+	})
+
+	errs, ok := err.(*gtools.IndexedErrors)
+	if !ok {
+		t.Fatalf("ParallelEach() error = %T, want *gtools.IndexedErrors", err)
+	}
+	if !reflect.DeepEqual(errs.Indexes(), []int{2, 7}) {
+		t.Errorf("ParallelEach() collected the errors of %v, want [2 7]:\n%s", errs.Indexes(), errs.Trace())
+	}
+	var ie *gtools.IndexedError
+	if !errors.As(err, &ie) || ie.Index != 2 || ie.Err.Error() != "bad input" {
+		t.Errorf("ParallelEach() error = %v, want the bad input of element 2 first", err)
+	}
+	if !strings.Contains(err.Error(), "element 7: ") || !strings.Contains(errs.Trace(), "element 7: ") {
+		t.Errorf("ParallelEach() error doesn't name the panicking element 7: %v", err)
+	}
+}
+
+func TestParallelEach_CancelWhileAcquiring(t *testing.T) {
+	sem := conc.NewChannelSemaphore(1)
+	sem.Acq()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	var calls int32
+	err := ParallelEach(ctx, ParallelConfig{Sem: sem}, progression.Sequence[int](5), func(context.Context, int) error {
+		atomic.AddInt32(&calls, 1)
+		return nil
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) || calls != 0 {
+		t.Errorf("ParallelEach() = %v after %d calls, want context.DeadlineExceeded before any call", err, calls)
+	}
+}
+
+type inputError struct {
+	v int
+}
+
+func (e *inputError) Error() string {
+	return "bad input"
+}
+
+func TestParallelMap_CollectAllKeepsTheError(t *testing.T) {
+	_, err := ParallelMap(context.Background(), ParallelConfig{Lim: 2, All: true}, progression.Sequence[int](10), func(_ context.Context, v int) (int, error) {
+		if v == 4 {
+			return 0, &inputError{v: v}
+		}
+		return v, nil
+	})
+
+	var ie *inputError
+	if !errors.As(err, &ie) || ie.v != 4 {
+		t.Errorf("ParallelMap() error = %v, want the inputError of 4", err)
+	}
+}
+
+func TestParallelFilter(t *testing.T) {
+	got, err := ParallelFilter(context.Background(), ParallelConfig{}, progression.Sequence[int](10), func(_ context.Context, v int) (bool, error) {
+		return v%3 == 0, nil
+	})
+	if err != nil || !reflect.DeepEqual(got, []int{0, 3, 6, 9}) {
+		t.Errorf("ParallelFilter() = %v, %v, want [0 3 6 9]", got, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err = ParallelFilter(ctx, ParallelConfig{}, progression.Sequence[int](10), func(context.Context, int) (bool, error) {
+		return true, nil
+	}); !errors.Is(err, context.Canceled) {
+		t.Errorf("ParallelFilter() error = %v with a cancelled context, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,414 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package pipe
+
+import (
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/datastr/iterables"
+	"github.com/andrerrcosta2/gtools/pkg/datastr/sets"
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"iter"
+	"sort"
+	"strings"
+)
+
+// Stream is a lazy pipeline of elements.
+//
+// Unlike Map, Filter and the other functions of this package, the intermediate operations
+// of a Stream don't allocate anything: they only describe the pipeline, and each element
+// flows through all the stages once a terminal operation, like ToSlice or ForEach, pulls it.
+// Only Sorted needs to buffer the elements.
+//
+// A Stream built from a slice, a sequence or a generator can be consumed many times,
+// while a Stream built from a channel or an iterator can only be consumed once.
+type Stream[T any] struct {
+	seq iter.Seq[T]
+}
+
+// Of creates a Stream of the values.
+func Of[T any](values ...T) *Stream[T] {
+	return FromSlice(values)
+}
+
+// FromSlice creates a Stream of the elements of the slice, without copying it.
+func FromSlice[T any](arr []T) *Stream[T] {
+	return &Stream[T]{seq: func(yield func(T) bool) {
+		for _, v := range arr {
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// FromChan creates a Stream of the values received from the channel, until it's closed.
+// If the stream stops early, the remaining values are left in the channel.
+func FromChan[T any](ch <-chan T) *Stream[T] {
+	return &Stream[T]{seq: func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// FromIterator creates a Stream of the values of the iterator.
+func FromIterator[T any](it iterables.Iterator[T]) *Stream[T] {
+	return &Stream[T]{seq: iterables.FromIterator(it)}
+}
+
+// FromSeq creates a Stream of the values of the sequence.
+func FromSeq[T any](seq iter.Seq[T]) *Stream[T] {
+	return &Stream[T]{seq: seq}
+}
+
+// Generate creates an infinite Stream of the values returned by the supplier.
+// It must be bounded by Take, TakeWhile or a short-circuiting terminal operation.
+func Generate[T any](f functions.Supplier[T]) *Stream[T] {
+	return &Stream[T]{seq: func(yield func(T) bool) {
+		for yield(f()) {
+		}
+	}}
+}
+
+// Iterate creates an infinite Stream of seed, f(seed), f(f(seed)) and so on.
+// It must be bounded by Take, TakeWhile or a short-circuiting terminal operation.
+func Iterate[T any](seed T, f functions.Function[T, T]) *Stream[T] {
+	return &Stream[T]{seq: func(yield func(T) bool) {
+		for v := seed; yield(v); v = f(v) {
+		}
+	}}
+}
+
+// Seq returns the stream as an iter.Seq, so it can be used in range loops.
+func (s *Stream[T]) Seq() iter.Seq[T] {
+	return s.seq
+}
+
+// Filter keeps the elements for which f returns true.
+func (s *Stream[T]) Filter(f functions.Predicate[T]) *Stream[T] {
+	return &Stream[T]{seq: func(yield func(T) bool) {
+		for v := range s.seq {
+			if f(v) && !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Map replaces each element by the result of f.
+// See MapStream for mappings changing the type of the elements.
+func (s *Stream[T]) Map(f functions.Function[T, T]) *Stream[T] {
+	return MapStream(s, f)
+}
+
+// Peek calls f for each element as it flows through the stream, without changing it.
+func (s *Stream[T]) Peek(f functions.Consumer[T]) *Stream[T] {
+	return &Stream[T]{seq: func(yield func(T) bool) {
+		for v := range s.seq {
+			f(v)
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Take keeps the first n elements, and stops pulling from the source after them.
+func (s *Stream[T]) Take(n int) *Stream[T] {
+	return &Stream[T]{seq: func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range s.seq {
+			if !yield(v) {
+				return
+			}
+			if i++; i >= n {
+				return
+			}
+		}
+	}}
+}
+
+// Limit is an alias of Take.
+func (s *Stream[T]) Limit(n int) *Stream[T] {
+	return s.Take(n)
+}
+
+// Skip drops the first n elements.
+func (s *Stream[T]) Skip(n int) *Stream[T] {
+	return &Stream[T]{seq: func(yield func(T) bool) {
+		i := 0
+		for v := range s.seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// TakeWhile keeps the elements until f returns false for the first time.
+func (s *Stream[T]) TakeWhile(f functions.Predicate[T]) *Stream[T] {
+	return &Stream[T]{seq: func(yield func(T) bool) {
+		for v := range s.seq {
+			if !f(v) || !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// SkipWhile drops the elements until f returns false for the first time.
+func (s *Stream[T]) SkipWhile(f functions.Predicate[T]) *Stream[T] {
+	return &Stream[T]{seq: func(yield func(T) bool) {
+		skipping := true
+		for v := range s.seq {
+			if skipping && f(v) {
+				continue
+			}
+			skipping = false
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Distinct drops the elements equal to an element already seen, keeping the first occurrence.
+// The elements must be comparable at runtime, otherwise it panics. See DistinctBy for other types.
+func (s *Stream[T]) Distinct() *Stream[T] {
+	return &Stream[T]{seq: func(yield func(T) bool) {
+		seen := make(map[any]struct{})
+		for v := range s.seq {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// Sorted sorts the elements by the less function, keeping the order of equal elements.
+// It has to buffer every element of the source before yielding the first one,
+// so it must not be used on infinite streams.
+func (s *Stream[T]) Sorted(less functions.BiFunction[T, T, bool]) *Stream[T] {
+	return &Stream[T]{seq: func(yield func(T) bool) {
+		buf := s.ToSlice()
+		sort.SliceStable(buf, func(i, j int) bool {
+			return less(buf[i], buf[j])
+		})
+		for _, v := range buf {
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// ToSlice collects the elements into a new slice.
+func (s *Stream[T]) ToSlice() []T {
+	result := make([]T, 0)
+	for v := range s.seq {
+		result = append(result, v)
+	}
+	return result
+}
+
+// ForEach calls f for each element.
+func (s *Stream[T]) ForEach(f functions.Consumer[T]) {
+	for v := range s.seq {
+		f(v)
+	}
+}
+
+// Count returns the number of elements.
+func (s *Stream[T]) Count() int {
+	n := 0
+	for range s.seq {
+		n++
+	}
+	return n
+}
+
+// First returns the first element.
+func (s *Stream[T]) First() (T, bool) {
+	for v := range s.seq {
+		return v, true
+	}
+	return *new(T), false
+}
+
+// FindFirst returns the first element for which f returns true.
+func (s *Stream[T]) FindFirst(f functions.Predicate[T]) (T, bool) {
+	return s.Filter(f).First()
+}
+
+// AnyMatch checks if f returns true for any element, stopping at the first match.
+func (s *Stream[T]) AnyMatch(f functions.Predicate[T]) bool {
+	_, ok := s.FindFirst(f)
+	return ok
+}
+
+// AllMatch checks if f returns true for every element, stopping at the first mismatch.
+func (s *Stream[T]) AllMatch(f functions.Predicate[T]) bool {
+	for v := range s.seq {
+		if !f(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// NoneMatch checks if f returns false for every element, stopping at the first match.
+func (s *Stream[T]) NoneMatch(f functions.Predicate[T]) bool {
+	return !s.AnyMatch(f)
+}
+
+// Reduce folds the elements from the left, starting with init.
+// See ReduceStream for reductions changing the type of the result.
+func (s *Stream[T]) Reduce(init T, f functions.BiFunction[T, T, T]) T {
+	return ReduceStream(s, init, f)
+}
+
+// Min returns the smallest element according to the less function.
+func (s *Stream[T]) Min(less functions.BiFunction[T, T, bool]) (T, bool) {
+	return s.best(func(a, b T) bool { return less(b, a) })
+}
+
+// Max returns the greatest element according to the less function.
+func (s *Stream[T]) Max(less functions.BiFunction[T, T, bool]) (T, bool) {
+	return s.best(less)
+}
+
+// best returns the first element that no later element replaces according to the function.
+func (s *Stream[T]) best(replaces functions.BiFunction[T, T, bool]) (T, bool) {
+	var best T
+	found := false
+	for v := range s.seq {
+		if !found || replaces(best, v) {
+			best, found = v, true
+		}
+	}
+	return best, found
+}
+
+// MapStream replaces each element of the stream by the result of f.
+func MapStream[T any, R any](s *Stream[T], f functions.Function[T, R]) *Stream[R] {
+	return &Stream[R]{seq: func(yield func(R) bool) {
+		for v := range s.seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}}
+}
+
+// FlatMapStream replaces each element of the stream by the elements of the slice returned by f.
+func FlatMapStream[T any, R any](s *Stream[T], f functions.Function[T, []R]) *Stream[R] {
+	return &Stream[R]{seq: func(yield func(R) bool) {
+		for v := range s.seq {
+			for _, r := range f(v) {
+				if !yield(r) {
+					return
+				}
+			}
+		}
+	}}
+}
+
+// DistinctBy drops the elements whose key was already seen, keeping the first occurrence.
+func DistinctBy[T any, K comparable](s *Stream[T], key functions.Function[T, K]) *Stream[T] {
+	return &Stream[T]{seq: func(yield func(T) bool) {
+		seen := make(map[K]struct{})
+		for v := range s.seq {
+			k := key(v)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}}
+}
+
+// ReduceStream folds the elements of the stream from the left, starting with init.
+func ReduceStream[T any, R any](s *Stream[T], init R, f functions.BiFunction[R, T, R]) R {
+	result := init
+	for v := range s.seq {
+		result = f(result, v)
+	}
+	return result
+}
+
+// ToSet collects the elements of the stream into a set.
+func ToSet[T comparable](s *Stream[T]) *sets.ComparableSet[T] {
+	set := sets.Comparable[T]()
+	for v := range s.seq {
+		set.Add(v)
+	}
+	return set
+}
+
+// ToMap collects the elements of the stream into a map.
+// If several elements have the same key, the last one wins.
+func ToMap[T any, K comparable, V any](s *Stream[T], key functions.Function[T, K], value functions.Function[T, V]) map[K]V {
+	result := make(map[K]V)
+	for v := range s.seq {
+		result[key(v)] = value(v)
+	}
+	return result
+}
+
+// GroupBy collects the elements of the stream into groups sharing the same key.
+// The elements of each group keep the order of the stream.
+func GroupBy[T any, K comparable](s *Stream[T], key functions.Function[T, K]) map[K][]T {
+	result := make(map[K][]T)
+	for v := range s.seq {
+		k := key(v)
+		result[k] = append(result[k], v)
+	}
+	return result
+}
+
+// PartitionBy splits the elements of the stream by the predicate, keeping their order.
+//
+// Returns:
+// - []T: The elements for which f returns true.
+// - []T: The elements for which f returns false.
+func PartitionBy[T any](s *Stream[T], f functions.Predicate[T]) ([]T, []T) {
+	matched, unmatched := make([]T, 0), make([]T, 0)
+	for v := range s.seq {
+		if f(v) {
+			matched = append(matched, v)
+		} else {
+			unmatched = append(unmatched, v)
+		}
+	}
+	return matched, unmatched
+}
+
+// Joining concatenates the elements of the stream, formatted with fmt.Sprint, separated by sep.
+func Joining[T any](s *Stream[T], sep string) string {
+	var sb strings.Builder
+	first := true
+	for v := range s.seq {
+		if !first {
+			sb.WriteString(sep)
+		}
+		first = false
+		sb.WriteString(fmt.Sprint(v))
+	}
+	return sb.String()
+}
@@ -0,0 +1,115 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package pipe
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/num/progression"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestStream_Lazy(t *testing.T) {
+	pulled := 0
+	got := Of(1, 2, 3, 4, 5, 6, 7, 8).
+		Peek(func(int) { pulled++ }).
+		Filter(func(v int) bool { return v%2 == 0 }).
+		Map(func(v int) int { return v * 10 }).
+		Take(2).
+		ToSlice()
+
+	if !reflect.DeepEqual(got, []int{20, 40}) {
+		t.Errorf("ToSlice() = %v, want [20 40]", got)
+	}
+	// The source stops being pulled once Take is satisfied
+	if pulled != 4 {
+		t.Errorf("pulled %d elements, want 4", pulled)
+	}
+}
+
+func TestStream_Operations(t *testing.T) {
+	s := Of(5, 3, 5, 1, 4, 3, 2)
+
+	if got := s.Distinct().ToSlice(); !reflect.DeepEqual(got, []int{5, 3, 1, 4, 2}) {
+		t.Errorf("Distinct() = %v, want [5 3 1 4 2]", got)
+	}
+	less := func(a, b int) bool { return a < b }
+	if got := s.Sorted(less).Skip(2).Limit(3).ToSlice(); !reflect.DeepEqual(got, []int{3, 3, 4}) {
+		t.Errorf("Sorted().Skip().Limit() = %v, want [3 3 4]", got)
+	}
+	if got := s.SkipWhile(func(v int) bool { return v > 2 }).TakeWhile(func(v int) bool { return v < 5 }).ToSlice(); !reflect.DeepEqual(got, []int{1, 4, 3, 2}) {
+		t.Errorf("SkipWhile().TakeWhile() = %v, want [1 4 3 2]", got)
+	}
+	if m, _ := s.Min(less); m != 1 {
+		t.Errorf("Min() = %d, want 1", m)
+	}
+	if m, _ := s.Max(less); m != 5 {
+		t.Errorf("Max() = %d, want 5", m)
+	}
+	if sum := s.Reduce(0, func(a, b int) int { return a + b }); sum != 23 {
+		t.Errorf("Reduce() = %d, want 23", sum)
+	}
+	if !s.AnyMatch(func(v int) bool { return v == 4 }) || s.AllMatch(func(v int) bool { return v > 1 }) {
+		t.Errorf("AnyMatch() or AllMatch() returned the wrong result")
+	}
+	if _, ok := Of[int]().First(); ok {
+		t.Errorf("First() found an element in an empty stream")
+	}
+}
+
+func TestStream_Sources(t *testing.T) {
+	ch := make(chan string, 3)
+	ch <- "a"
+	ch <- "b"
+	ch <- "c"
+	close(ch)
+	if got := Joining(FromChan(ch), ","); got != "a,b,c" {
+		t.Errorf("Joining(FromChan()) = %q, want a,b,c", got)
+	}
+
+	fib := FromSeq(progression.FibonacciSeq[int]()).Take(8).ToSlice()
+	if !reflect.DeepEqual(fib, []int{0, 1, 1, 2, 3, 5, 8, 13}) {
+		t.Errorf("FibonacciSeq() = %v", fib)
+	}
+
+	powers := Iterate(1, func(v int) int { return v * 2 }).TakeWhile(func(v int) bool { return v < 100 }).Count()
+	if powers != 7 {
+		t.Errorf("Iterate().TakeWhile().Count() = %d, want 7", powers)
+	}
+
+	n := 0
+	ones := Generate(func() int { n++; return n }).Limit(3).ToSlice()
+	if !reflect.DeepEqual(ones, []int{1, 2, 3}) {
+		t.Errorf("Generate().Limit() = %v, want [1 2 3]", ones)
+	}
+}
+
+func TestStream_Collectors(t *testing.T) {
+	lines := FromSlice([]string{"ERROR disk", "INFO start", "ERROR net", "WARN cpu", "INFO stop"})
+	level := func(line string) string { return line[:strings.IndexByte(line, ' ')] }
+
+	groups := GroupBy(lines, level)
+	if !reflect.DeepEqual(groups["ERROR"], []string{"ERROR disk", "ERROR net"}) || len(groups) != 3 {
+		t.Errorf("GroupBy() = %v", groups)
+	}
+
+	errs, others := PartitionBy(lines, func(line string) bool { return level(line) == "ERROR" })
+	if len(errs) != 2 || len(others) != 3 {
+		t.Errorf("PartitionBy() = %v, %v", errs, others)
+	}
+
+	levels := ToSet(MapStream(lines, level))
+	if levels.Len() != 3 || !levels.Has("WARN") {
+		t.Errorf("ToSet() = %v", levels.Values())
+	}
+
+	lengths := ToMap(lines, func(line string) string { return line }, func(line string) int { return len(line) })
+	if lengths["WARN cpu"] != 8 {
+		t.Errorf("ToMap() = %v", lengths)
+	}
+
+	words := FlatMapStream(lines, strings.Fields)
+	if got := DistinctBy(words, strings.ToLower).Count(); got != 8 {
+		t.Errorf("DistinctBy().Count() = %d, want 8", got)
+	}
+}
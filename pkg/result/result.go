@@ -0,0 +1,199 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+// Package result: this package holds the Result type, a value or an error in a single value.
+//
+// 1. It complements opt.Option for the operations that can fail.
+// 2. A Result can be sent through a channel, unlike a (T, error) pair.
+// 3. It's immutable, so it's safe to share between goroutines.
+package result
+
+import (
+	"errors"
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/functions"
+	"github.com/andrerrcosta2/gtools/pkg/opt"
+)
+
+// Result holds either a value or an error.
+// The zero value is a successful Result holding the zero value of T.
+type Result[T any] struct {
+	val T
+	err error
+}
+
+// Ok returns a successful Result holding the value.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{val: value}
+}
+
+// Err returns a failed Result holding the error.
+// It panics if the error is nil.
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic("result.Err called with a nil error")
+	}
+	return Result[T]{err: err}
+}
+
+// From returns a Result from a (T, error) pair: failed if the error isn't nil, successful otherwise.
+func From[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Result[T]{err: err}
+	}
+	return Result[T]{val: value}
+}
+
+// Try calls f and returns its result as a Result.
+func Try[T any](f func() (T, error)) Result[T] {
+	return From(f())
+}
+
+// Async calls f in a new goroutine, and returns a channel receiving its result as a Result.
+// The channel is buffered, so the goroutine ends even if the result is never received.
+func Async[T any](f func() (T, error)) <-chan Result[T] {
+	ch := make(chan Result[T], 1)
+	go func() {
+		ch <- Try(f)
+	}()
+	return ch
+}
+
+// IsOk checks if the Result holds a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr checks if the Result holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the Result as a (T, error) pair.
+func (r Result[T]) Get() (T, error) {
+	return r.val, r.err
+}
+
+// Err returns the error of the Result, or nil if it's successful.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value of the Result.
+// It panics if the Result holds an error.
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Sprintf("unwrapping a failed result: %v", r.err))
+	}
+	return r.val
+}
+
+// Expect returns the value of the Result.
+// It panics with the message and the error if the Result holds an error.
+func (r Result[T]) Expect(msg string) T {
+	if r.err != nil {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+	return r.val
+}
+
+// OrElse returns the value of the Result if it's successful, otherwise it returns the provided value.
+func (r Result[T]) OrElse(value T) T {
+	if r.err != nil {
+		return value
+	}
+	return r.val
+}
+
+// OrElseFunc returns the value of the Result if it's successful,
+// otherwise it returns the value computed by f from the error.
+func (r Result[T]) OrElseFunc(f functions.Function[error, T]) T {
+	if r.err != nil {
+		return f(r.err)
+	}
+	return r.val
+}
+
+// ToOption returns an Option holding the value of the Result if it's successful, or a None Option.
+func (r Result[T]) ToOption() *opt.Option[T] {
+	if r.err != nil {
+		return opt.None[T]()
+	}
+	return opt.Of(r.val)
+}
+
+func (r Result[T]) String() string {
+	if r.err != nil {
+		return fmt.Sprintf("Err(%v)", r.err)
+	}
+	return fmt.Sprintf("Ok(%v)", r.val)
+}
+
+// Map returns a Result holding f applied to the value of r, or the error of r.
+func Map[T any, R any](r Result[T], f functions.Function[T, R]) Result[R] {
+	if r.err != nil {
+		return Result[R]{err: r.err}
+	}
+	return Result[R]{val: f(r.val)}
+}
+
+// FlatMap returns the Result of f applied to the value of r, or the error of r.
+func FlatMap[T any, R any](r Result[T], f functions.Function[T, Result[R]]) Result[R] {
+	if r.err != nil {
+		return Result[R]{err: r.err}
+	}
+	return f(r.val)
+}
+
+// MapErr returns a Result holding f applied to the error of r, or the value of r.
+// It's useful to wrap the error with some context. If f returns nil, the Result is successful
+// and holds the zero value of T.
+func MapErr[T any](r Result[T], f functions.Function[error, error]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return From(r.val, f(r.err))
+}
+
+// Collect returns a Result holding the values of the results, in order,
+// or the error of the first failed one.
+func Collect[T any](results []Result[T]) Result[[]T] {
+	values := make([]T, len(results))
+	for i, r := range results {
+		if r.err != nil {
+			return Result[[]T]{err: r.err}
+		}
+		values[i] = r.val
+	}
+	return Result[[]T]{val: values}
+}
+
+// CollectAll returns a Result holding the values of the results, in order,
+// or the errors of every failed one joined by errors.Join.
+func CollectAll[T any](results []Result[T]) Result[[]T] {
+	values := make([]T, len(results))
+	var errs []error
+	for i, r := range results {
+		if r.err != nil {
+			errs = append(errs, r.err)
+			continue
+		}
+		values[i] = r.val
+	}
+	if len(errs) > 0 {
+		return Result[[]T]{err: errors.Join(errs...)}
+	}
+	return Result[[]T]{val: values}
+}
+
+// Partition splits the results into their values and their errors, keeping their order.
+func Partition[T any](results []Result[T]) ([]T, []error) {
+	values, errs := make([]T, 0), make([]error, 0)
+	for _, r := range results {
+		if r.err != nil {
+			errs = append(errs, r.err)
+		} else {
+			values = append(values, r.val)
+		}
+	}
+	return values, errs
+}
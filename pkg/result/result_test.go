@@ -0,0 +1,92 @@
+// Andre R. R. Costa * github.com/andrerrcosta2 * andrerrcosta@gmail.com
+
+package result
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestResult_Access(t *testing.T) {
+	ok := Ok(3)
+	failed := Err[int](errors.New("boom"))
+
+	if !ok.IsOk() || ok.Unwrap() != 3 || ok.OrElse(0) != 3 {
+		t.Errorf("Ok(3) = %v", ok)
+	}
+	if !failed.IsErr() || failed.OrElse(9) != 9 {
+		t.Errorf("Err() = %v", failed)
+	}
+	if v := failed.OrElseFunc(func(err error) int { return len(err.Error()) }); v != 4 {
+		t.Errorf("OrElseFunc() = %d, want 4", v)
+	}
+	if ok.String() != "Ok(3)" || failed.String() != "Err(boom)" {
+		t.Errorf("String() = %s and %s", ok, failed)
+	}
+	if !ok.ToOption().IsPresent() || failed.ToOption().IsPresent() {
+		t.Errorf("ToOption() didn't keep the presence of the value")
+	}
+
+	defer func() {
+		if r := recover(); r != "parsing the port: boom" {
+			t.Errorf("Expect() panicked with %v", r)
+		}
+	}()
+	failed.Expect("parsing the port")
+}
+
+func TestResult_Transform(t *testing.T) {
+	parsed := From(strconv.Atoi("21"))
+	doubled := Map(parsed, func(v int) int { return v * 2 })
+	if v, err := doubled.Get(); v != 42 || err != nil {
+		t.Errorf("Map() = %v, %v, want 42", v, err)
+	}
+
+	half := func(v int) Result[int] {
+		if v%2 != 0 {
+			return Err[int](fmt.Errorf("%d is odd", v))
+		}
+		return Ok(v / 2)
+	}
+	if r := FlatMap(Ok(3), half); r.IsOk() {
+		t.Errorf("FlatMap() = %v, want an error", r)
+	}
+
+	wrapped := MapErr(From(strconv.Atoi("x")), func(err error) error {
+		return fmt.Errorf("reading the config: %w", err)
+	})
+	if !errors.Is(wrapped.Err(), strconv.ErrSyntax) {
+		t.Errorf("MapErr() = %v, want a wrapped syntax error", wrapped)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	results := make(chan Result[int], 3)
+	for _, s := range []string{"1", "2", "3"} {
+		results <- <-Async(func() (int, error) { return strconv.Atoi(s) })
+	}
+	close(results)
+
+	var all []Result[int]
+	for r := range results {
+		all = append(all, r)
+	}
+	if values := Collect(all).Unwrap(); !reflect.DeepEqual(values, []int{1, 2, 3}) {
+		t.Errorf("Collect() = %v, want [1 2 3]", values)
+	}
+
+	all = append(all, Err[int](errors.New("a")), Ok(4), Err[int](errors.New("b")))
+	if err := Collect(all).Err(); err == nil || err.Error() != "a" {
+		t.Errorf("Collect() error = %v, want a", err)
+	}
+	if err := CollectAll(all).Err(); err == nil || err.Error() != "a\nb" {
+		t.Errorf("CollectAll() error = %v, want a and b", err)
+	}
+	values, errs := Partition(all)
+	if len(values) != 4 || len(errs) != 2 {
+		t.Errorf("Partition() = %v, %v", values, errs)
+	}
+}
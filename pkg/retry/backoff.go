@@ -0,0 +1,148 @@
+package retry
+
+import (
+	"github.com/andrerrcosta2/gtools/pkg/num/progression"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Backoff computes the delays between the attempts of Of and Each.
+// When Config.Bo is set, it replaces the Config.Bf factor.
+type Backoff interface {
+	// Delay returns the delay before the next attempt.
+	//
+	// Parameters:
+	// - attempt: The number of attempts that have failed, starting at 1.
+	// - last: The previous delay, which is Config.Dl after the first attempt.
+	Delay(attempt int, last time.Duration) time.Duration
+}
+
+// Constant returns a Backoff waiting the same delay before every attempt.
+func Constant(d time.Duration) Backoff {
+	return &constantBackoff{d: d}
+}
+
+type constantBackoff struct {
+	d time.Duration
+}
+
+func (b *constantBackoff) Delay(int, time.Duration) time.Duration {
+	return b.d
+}
+
+// Linear returns a Backoff whose delay grows by step after every attempt, starting at base.
+//
+// f(n) = base + step * (n - 1)
+func Linear(base time.Duration, step time.Duration) Backoff {
+	return &linearBackoff{base: base, step: step}
+}
+
+type linearBackoff struct {
+	base time.Duration
+	step time.Duration
+}
+
+func (b *linearBackoff) Delay(attempt int, _ time.Duration) time.Duration {
+	return b.base + b.step*time.Duration(attempt-1)
+}
+
+// Exponential returns a Backoff whose delay is multiplied by factor after every attempt,
+// starting at base and capped at maxDelay. A zero maxDelay means no cap.
+//
+// f(n) = min(base * factor^(n - 1), maxDelay)
+func Exponential(base time.Duration, factor float64, maxDelay time.Duration) Backoff {
+	return &exponentialBackoff{base: base, factor: factor, max: maxDelay}
+}
+
+type exponentialBackoff struct {
+	base   time.Duration
+	factor float64
+	max    time.Duration
+}
+
+func (b *exponentialBackoff) Delay(attempt int, _ time.Duration) time.Duration {
+	return capped(float64(b.base)*math.Pow(b.factor, float64(attempt-1)), b.max)
+}
+
+// fibonacciLength is the length of the Fibonacci sequence fitting in an int64.
+const fibonacciLength = 92
+
+// Fibonacci returns a Backoff whose delay follows the Fibonacci sequence, starting at base
+// and capped at maxDelay. A zero maxDelay means no cap.
+//
+// f(n) = min(base * fib(n), maxDelay), giving base, base, 2 * base, 3 * base, 5 * base...
+func Fibonacci(base time.Duration, maxDelay time.Duration) Backoff {
+	return &fibonacciBackoff{base: base, max: maxDelay, seq: progression.Fibonacci[int64](fibonacciLength)}
+}
+
+type fibonacciBackoff struct {
+	base time.Duration
+	max  time.Duration
+	seq  []int64
+}
+
+func (b *fibonacciBackoff) Delay(attempt int, _ time.Duration) time.Duration {
+	n := min(max(attempt, 1), len(b.seq)-1)
+	return capped(float64(b.base)*float64(b.seq[n]), b.max)
+}
+
+// FullJitter returns a Backoff waiting a random delay between zero and the delay of b.
+// It spreads the attempts of many clients the most, at the cost of some very short delays.
+func FullJitter(b Backoff) Backoff {
+	return &fullJitterBackoff{b: b, rnd: rand.Float64}
+}
+
+type fullJitterBackoff struct {
+	b   Backoff
+	rnd func() float64
+}
+
+func (j *fullJitterBackoff) Delay(attempt int, last time.Duration) time.Duration {
+	return time.Duration(j.rnd() * float64(j.b.Delay(attempt, last)))
+}
+
+// EqualJitter returns a Backoff waiting half the delay of b plus a random delay up to the other half.
+func EqualJitter(b Backoff) Backoff {
+	return &equalJitterBackoff{b: b, rnd: rand.Float64}
+}
+
+type equalJitterBackoff struct {
+	b   Backoff
+	rnd func() float64
+}
+
+func (j *equalJitterBackoff) Delay(attempt int, last time.Duration) time.Duration {
+	half := float64(j.b.Delay(attempt, last)) / 2
+	return time.Duration(half + j.rnd()*half)
+}
+
+// DecorrelatedJitter returns a Backoff waiting a random delay between base and three times
+// the previous delay, capped at maxDelay. A zero maxDelay means no cap.
+//
+// f(n) = min(random(base, 3 * f(n - 1)), maxDelay)
+func DecorrelatedJitter(base time.Duration, maxDelay time.Duration) Backoff {
+	return &decorrelatedJitterBackoff{base: base, max: maxDelay, rnd: rand.Float64}
+}
+
+type decorrelatedJitterBackoff struct {
+	base time.Duration
+	max  time.Duration
+	rnd  func() float64
+}
+
+func (j *decorrelatedJitterBackoff) Delay(_ int, last time.Duration) time.Duration {
+	upper := 3 * float64(max(last, j.base))
+	return capped(float64(j.base)+j.rnd()*(upper-float64(j.base)), j.max)
+}
+
+// capped converts the delay to a duration, capping it at maxDelay unless it is zero.
+func capped(d float64, maxDelay time.Duration) time.Duration {
+	if maxDelay > 0 && d >= float64(maxDelay) {
+		return maxDelay
+	}
+	if d >= math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(d)
+}
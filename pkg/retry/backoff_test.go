@@ -0,0 +1,98 @@
+package retry
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func delays(b Backoff, n int, first time.Duration) []time.Duration {
+	var ds []time.Duration
+	last := first
+	for i := 1; i <= n; i++ {
+		last = b.Delay(i, last)
+		ds = append(ds, last)
+	}
+	return ds
+}
+
+func TestBackoff_Deterministic(t *testing.T) {
+	ms := time.Millisecond
+	tests := []struct {
+		name string
+		b    Backoff
+		want []time.Duration
+	}{
+		{"constant", Constant(5 * ms), []time.Duration{5 * ms, 5 * ms, 5 * ms}},
+		{"linear", Linear(10*ms, 5*ms), []time.Duration{10 * ms, 15 * ms, 20 * ms}},
+		{"exponential", Exponential(10*ms, 2, 50*ms), []time.Duration{10 * ms, 20 * ms, 40 * ms, 50 * ms}},
+		{"fibonacci", Fibonacci(ms, 4*ms), []time.Duration{ms, ms, 2 * ms, 3 * ms, 4 * ms}},
+	}
+	for _, tt := range tests {
+		got := delays(tt.b, len(tt.want), 0)
+		if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+			t.Errorf("%s delays = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// A huge attempt number doesn't overflow
+	if d := Exponential(time.Second, 2, 0).Delay(10_000, 0); d <= 0 {
+		t.Errorf("Exponential() delay overflowed: %v", d)
+	}
+	if d := Fibonacci(time.Second, time.Hour).Delay(10_000, 0); d != time.Hour {
+		t.Errorf("Fibonacci() delay = %v, want the cap", d)
+	}
+}
+
+func TestBackoff_Jitter(t *testing.T) {
+	base := Constant(100 * time.Millisecond)
+	full := FullJitter(base).(*fullJitterBackoff)
+	equal := EqualJitter(base).(*equalJitterBackoff)
+	decorrelated := DecorrelatedJitter(10*time.Millisecond, time.Second).(*decorrelatedJitterBackoff)
+
+	for _, r := range []float64{0, 0.5, 0.999} {
+		full.rnd, equal.rnd, decorrelated.rnd = func() float64 { return r }, func() float64 { return r }, func() float64 { return r }
+
+		if d := full.Delay(1, 0); d != time.Duration(r*float64(100*time.Millisecond)) {
+			t.Errorf("FullJitter() delay = %v for %v", d, r)
+		}
+		if d := equal.Delay(1, 0); d < 50*time.Millisecond || d > 100*time.Millisecond {
+			t.Errorf("EqualJitter() delay = %v, want between 50ms and 100ms", d)
+		}
+		if d := decorrelated.Delay(2, 100*time.Millisecond); d < 10*time.Millisecond || d > 300*time.Millisecond {
+			t.Errorf("DecorrelatedJitter() delay = %v, want between 10ms and 300ms", d)
+		}
+	}
+
+	decorrelated.rnd = func() float64 { return 1 }
+	if d := decorrelated.Delay(5, time.Hour); d != time.Second {
+		t.Errorf("DecorrelatedJitter() delay = %v, want the cap", d)
+	}
+}
+
+func TestOf_Backoff(t *testing.T) {
+	var waits []time.Duration
+	config := defaultConfig[any](0, 4, 0)
+	config.Bo = recorder{b: Linear(time.Millisecond, time.Millisecond), waits: &waits}
+
+	_, err := Of(config, func() (int, error) {
+		return 0, fmt.Errorf("failed")
+	})
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+	if fmt.Sprint(waits) != "[1ms 2ms 3ms]" {
+		t.Errorf("the backoff computed %v, want [1ms 2ms 3ms]", waits)
+	}
+}
+
+type recorder struct {
+	b     Backoff
+	waits *[]time.Duration
+}
+
+func (r recorder) Delay(attempt int, last time.Duration) time.Duration {
+	d := r.b.Delay(attempt, last)
+	*r.waits = append(*r.waits, d)
+	return d
+}
@@ -0,0 +1,146 @@
+package retry
+
+import (
+	"errors"
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/gtools"
+	"strings"
+	"time"
+)
+
+// Permanent wraps an error so it isn't retried: Of and Each stop at once and return err.
+// It returns nil if err is nil.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PermanentError{Err: err}
+}
+
+// PermanentError is an error that must not be retried.
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// IsPermanent checks if the error, or any error it wraps, is a PermanentError.
+func IsPermanent(err error) bool {
+	var perm *PermanentError
+	return errors.As(err, &perm)
+}
+
+// WithRetryAfter wraps an error with a hint of how long to wait before the next attempt,
+// like the Retry-After header of an HTTP response. It returns nil if err is nil.
+func WithRetryAfter(err error, d time.Duration) error {
+	if err == nil {
+		return nil
+	}
+	return &retryAfterError{err: err, after: d}
+}
+
+type retryAfterError struct {
+	err   error
+	after time.Duration
+}
+
+func (e *retryAfterError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryAfterError) Unwrap() error {
+	return e.err
+}
+
+func (e *retryAfterError) RetryAfter() time.Duration {
+	return e.after
+}
+
+// RetryAfter returns the wait hinted by the error, or by any error it wraps, through a
+// RetryAfter() time.Duration method, or zero if there's none.
+func RetryAfter(err error) time.Duration {
+	var hint interface{ RetryAfter() time.Duration }
+	if errors.As(err, &hint) {
+		return hint.RetryAfter()
+	}
+	return 0
+}
+
+// retryable checks if the error can be retried according to the configuration.
+func retryable[T any](opt *Config[T], err error) bool {
+	if IsPermanent(err) {
+		return false
+	}
+	var leveled gtools.LeveledError
+	if opt.Sev > 0 && errors.As(err, &leveled) && leveled.Level().Severity >= opt.Sev {
+		return false
+	}
+	return opt.Rtr == nil || opt.Rtr(err)
+}
+
+// cause returns the error wrapped by the error if it's a PermanentError, or the error itself.
+func cause(err error) error {
+	if perm, ok := err.(*PermanentError); ok {
+		return perm.Err
+	}
+	return err
+}
+
+// Attempt describes a failed attempt of Of or Each.
+type Attempt struct {
+	// Index is the position of the attempt, from zero
+	Index int
+	// Err is the error returned by the attempt
+	Err error
+	// Dur is how long the attempt took
+	Dur time.Duration
+	// Prm is the parameter of the attempt, or nil if there's none
+	Prm any
+}
+
+func (a Attempt) String() string {
+	if a.Prm != nil {
+		return fmt.Sprintf("attempt %d (%v) failed after %v: %s", a.Index, a.Prm, a.Dur, a.Err)
+	}
+	return fmt.Sprintf("attempt %d failed after %v: %s", a.Index, a.Dur, a.Err)
+}
+
+// AttemptsError is returned by Of and Each when all the attempts failed.
+// errors.Is and errors.As look into the error of every attempt.
+type AttemptsError struct {
+	Attempts []Attempt
+}
+
+func (e *AttemptsError) Error() string {
+	lines := make([]string, len(e.Attempts))
+	for i, a := range e.Attempts {
+		lines[i] = a.String()
+	}
+	return fmt.Sprintf("all %d attempts failed\n\n%s", len(e.Attempts), strings.Join(lines, "\n"))
+}
+
+// Unwrap returns the errors of the attempts.
+func (e *AttemptsError) Unwrap() []error {
+	errs := make([]error, len(e.Attempts))
+	for i, a := range e.Attempts {
+		errs[i] = a.Err
+	}
+	return errs
+}
+
+// Trace returns the attempts as a gtools error trace.
+func (e *AttemptsError) Trace() string {
+	stk := make([]error, len(e.Attempts))
+	for i, a := range e.Attempts {
+		stk[i] = errors.New(a.String())
+	}
+	return gtools.ReadTrace(stk)
+}
+
+var _ gtools.StackableError = (*AttemptsError)(nil)
@@ -0,0 +1,224 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/andrerrcosta2/gtools/pkg/gtools"
+	"strings"
+	"testing"
+	"time"
+)
+
+type leveledError struct {
+	severity gtools.ErrorSeverity
+}
+
+func (e *leveledError) Error() string {
+	return fmt.Sprintf("leveled error %d", e.severity)
+}
+
+func (e *leveledError) Level() gtools.ErrorLevel {
+	return gtools.NewErrorLevel("test", e.severity)
+}
+
+func (e *leveledError) Severity(err gtools.LeveledError) int {
+	return int(e.severity) - int(err.Level().Severity)
+}
+
+func TestOf_Permanent(t *testing.T) {
+	config := defaultConfig[any](time.Millisecond, 3, 0)
+	boom := errors.New("boom")
+	attempts := 0
+
+	_, err := Of(config, func() (int, error) {
+		attempts++
+		return 0, Permanent(boom)
+	})
+
+	if err != boom {
+		t.Errorf("expected the permanent error %v, got %v", boom, err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestEach_Retryable(t *testing.T) {
+	config := defaultConfig[int](time.Millisecond, 3, 0)
+	config.Prm = []int{1, 2, 3}
+	notFound := errors.New("not found")
+	config.Rtr = func(err error) bool {
+		return !errors.Is(err, notFound)
+	}
+	var params []int
+
+	_, err := Each(config, func(p int) (int, error) {
+		params = append(params, p)
+		if p == 2 {
+			return 0, fmt.Errorf("param %d: %w", p, notFound)
+		}
+		return 0, errors.New("unavailable")
+	})
+
+	if !errors.Is(err, notFound) {
+		t.Errorf("expected the error to wrap %v, got %v", notFound, err)
+	}
+	if len(params) != 2 {
+		t.Errorf("expected 2 attempts, got %v", params)
+	}
+}
+
+func TestOf_Severity(t *testing.T) {
+	config := defaultConfig[any](time.Millisecond, 3, 0)
+	config.Sev = 5
+	attempts := 0
+
+	_, err := Of(config, func() (int, error) {
+		attempts++
+		return 0, fmt.Errorf("request: %w", &leveledError{severity: gtools.ErrorSeverity(attempts * 3)})
+	})
+
+	var leveled *leveledError
+	if !errors.As(err, &leveled) || leveled.severity != 6 {
+		t.Errorf("expected the error of severity 6, got %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestOf_RetryAfter(t *testing.T) {
+	config := defaultConfig[any](time.Millisecond, 2, 0)
+	attempts := 0
+	start := time.Now()
+
+	_, err := Of(config, func() (int, error) {
+		attempts++
+		if attempts == 1 {
+			return 0, WithRetryAfter(errors.New("too many requests"), 50*time.Millisecond)
+		}
+		return 42, nil
+	})
+
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected to wait the hinted 50ms, waited %v", elapsed)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	err := fmt.Errorf("get: %w", WithRetryAfter(errors.New("busy"), time.Second))
+
+	if d := RetryAfter(err); d != time.Second {
+		t.Errorf("expected 1s, got %v", d)
+	}
+	if d := RetryAfter(errors.New("busy")); d != 0 {
+		t.Errorf("expected no hint, got %v", d)
+	}
+	if Permanent(nil) != nil || WithRetryAfter(nil, time.Second) != nil {
+		t.Errorf("expected nil errors to stay nil")
+	}
+}
+
+func TestEach_AttemptsError(t *testing.T) {
+	config := defaultConfig[string](time.Millisecond, 2, 0)
+	config.Prm = []string{"a", "b"}
+	unavailable := errors.New("unavailable")
+	var logged []Attempt
+	config.Log = func(a Attempt) {
+		logged = append(logged, a)
+	}
+
+	_, err := Each(config, func(p string) (int, error) {
+		return 0, fmt.Errorf("host %s: %w", p, unavailable)
+	})
+
+	var attErr *AttemptsError
+	if !errors.As(err, &attErr) || len(attErr.Attempts) != 2 {
+		t.Fatalf("expected an AttemptsError with 2 attempts, got %v", err)
+	}
+	if !errors.Is(err, unavailable) {
+		t.Errorf("expected the error to wrap %v", unavailable)
+	}
+	if attErr.Attempts[1].Prm != "b" || attErr.Attempts[1].Index != 1 {
+		t.Errorf("expected the second attempt to have the parameter b, got %+v", attErr.Attempts[1])
+	}
+	if stk, ok := gtools.AsStackable(err); !ok || !strings.Contains(stk.Trace(), "host b: unavailable") {
+		t.Errorf("expected a stackable error tracing the attempts, got %v", err)
+	}
+	if len(logged) != 2 || logged[0].Err == nil {
+		t.Errorf("expected the 2 failed attempts to be logged, got %v", logged)
+	}
+}
+
+func TestOf_Timeout(t *testing.T) {
+	config := defaultConfig[any](time.Millisecond, 2, 0)
+	config.Tmo = 10 * time.Millisecond
+	start := time.Now()
+
+	_, err := Of(config, func() (int, error) {
+		time.Sleep(time.Second)
+		return 42, nil
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the attempts to time out, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected the attempts to be abandoned, waited %v", elapsed)
+	}
+}
+
+func TestRun_TimeoutOnlyOnTheAttemptDeadline(t *testing.T) {
+	hang := func(ctx context.Context, _ int) (int, error) {
+		<-ctx.Done()
+		time.Sleep(50 * time.Millisecond)
+		return 0, ctx.Err()
+	}
+
+	config := defaultConfig[any](0, 1, 0)
+	config.Tmo = 10 * time.Millisecond
+	if _, err := run(&config, 0, hang); !errors.Is(err, context.DeadlineExceeded) || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("expected the attempt to time out, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(5*time.Millisecond, cancel)
+	config.Ctx = ctx
+	config.Tmo = time.Second
+	if _, err := run(&config, 0, hang); err != context.Canceled {
+		t.Errorf("expected the cancellation of the parent context, got %v", err)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	config.Ctx = ctx
+	if _, err := run(&config, 0, hang); err != context.DeadlineExceeded {
+		t.Errorf("expected the deadline of the parent context, got %v", err)
+	}
+}
+
+func TestEachCtx_Timeout(t *testing.T) {
+	config := defaultConfig[time.Duration](time.Millisecond, 2, 0)
+	config.Tmo = 20 * time.Millisecond
+	config.Prm = []time.Duration{time.Second, time.Millisecond}
+
+	result, err := EachCtx(config, func(ctx context.Context, d time.Duration) (time.Duration, error) {
+		select {
+		case <-time.After(d):
+			return d, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	})
+
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if result != time.Millisecond {
+		t.Errorf("expected the second attempt to succeed, got %v", result)
+	}
+}
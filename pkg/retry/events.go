@@ -0,0 +1,166 @@
+package retry
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// EventKind tells what happened to the attempts of an operation.
+type EventKind int
+
+const (
+	// Retried means an attempt failed and another one is going to be made
+	Retried EventKind = iota
+	// GaveUp means the attempts stopped without a success
+	GaveUp
+	// Succeeded means an attempt succeeded
+	Succeeded
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case Retried:
+		return "retried"
+	case GaveUp:
+		return "gave up"
+	case Succeeded:
+		return "succeeded"
+	}
+	return fmt.Sprintf("EventKind(%d)", int(k))
+}
+
+// RetryEvent describes what happened after an attempt of Of or Each.
+type RetryEvent struct {
+	// Op is the Config.Op of the operation
+	Op   string
+	Kind EventKind
+	// Attempt is the number of attempts made so far
+	Attempt int
+	// Delay is the delay before the next attempt, only set when the Kind is Retried
+	Delay time.Duration
+	// Err is the error of the failed attempt when the Kind is Retried, and the error
+	// returned to the caller when it's GaveUp
+	Err error
+	// Elapsed is the time since the operation started
+	Elapsed time.Duration
+}
+
+func (e RetryEvent) String() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s %s after %d attempts in %v: %s", e.Op, e.Kind, e.Attempt, e.Elapsed, e.Err)
+	}
+	return fmt.Sprintf("%s %s after %d attempts in %v", e.Op, e.Kind, e.Attempt, e.Elapsed)
+}
+
+// notify sends the event to the hooks, the publisher and the metrics of the configuration.
+func notify[T any](opt *Config[T], ev RetryEvent) {
+	ev.Op = opt.Op
+	if opt.Mtr != nil {
+		opt.Mtr.record(ev)
+	}
+
+	var hook func(RetryEvent)
+	switch ev.Kind {
+	case Retried:
+		hook = opt.OnRetry
+	case GaveUp:
+		hook = opt.OnGiveUp
+	case Succeeded:
+		hook = opt.OnSuccess
+	}
+	if hook != nil {
+		hook(ev)
+	}
+
+	if opt.Pub != nil && !opt.Pub.Cld() {
+		opt.Pub.Nxt(ev)
+	}
+}
+
+// NewMetrics creates an empty Metrics.
+func NewMetrics() *Metrics {
+	return &Metrics{}
+}
+
+// Metrics counts the attempts and their outcomes for every operation name.
+// A Metrics can be shared by many configurations. This is Thread-Safe.
+type Metrics struct {
+	ops sync.Map
+}
+
+// Counts are the counters of an operation.
+type Counts struct {
+	// Attempts is the number of attempts made
+	Attempts int64
+	// Retries is the number of failed attempts followed by another one
+	Retries int64
+	// Successes is the number of operations which succeeded
+	Successes int64
+	// Failures is the number of operations which gave up
+	Failures int64
+}
+
+type counters struct {
+	attempts  atomic.Int64
+	retries   atomic.Int64
+	successes atomic.Int64
+	failures  atomic.Int64
+}
+
+func (c *counters) counts() Counts {
+	return Counts{
+		Attempts:  c.attempts.Load(),
+		Retries:   c.retries.Load(),
+		Successes: c.successes.Load(),
+		Failures:  c.failures.Load(),
+	}
+}
+
+// Get returns the counters of the operation.
+func (m *Metrics) Get(op string) Counts {
+	if c, ok := m.ops.Load(op); ok {
+		return c.(*counters).counts()
+	}
+	return Counts{}
+}
+
+// Snapshot returns the counters of every operation, by operation name.
+func (m *Metrics) Snapshot() map[string]Counts {
+	snap := make(map[string]Counts)
+	m.ops.Range(func(op, c any) bool {
+		snap[op.(string)] = c.(*counters).counts()
+		return true
+	})
+	return snap
+}
+
+// Reset drops every counter.
+func (m *Metrics) Reset() {
+	m.ops.Clear()
+}
+
+func (m *Metrics) counters(op string) *counters {
+	if c, ok := m.ops.Load(op); ok {
+		return c.(*counters)
+	}
+	c, _ := m.ops.LoadOrStore(op, &counters{})
+	return c.(*counters)
+}
+
+func (m *Metrics) attempt(op string) {
+	m.counters(op).attempts.Add(1)
+}
+
+func (m *Metrics) record(ev RetryEvent) {
+	c := m.counters(ev.Op)
+	switch ev.Kind {
+	case Retried:
+		c.retries.Add(1)
+	case GaveUp:
+		c.failures.Add(1)
+	case Succeeded:
+		c.successes.Add(1)
+	}
+}
@@ -0,0 +1,126 @@
+package retry
+
+import (
+	"errors"
+	"github.com/andrerrcosta2/gtools/pkg/obs"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// subject records the events published to it.
+type subject struct {
+	evs []RetryEvent
+	cld bool
+}
+
+func (s *subject) Sub(*obs.Obv[RetryEvent]) (*obs.Sub[RetryEvent], error) { return nil, nil }
+func (s *subject) Nxt(ev RetryEvent)                                      { s.evs = append(s.evs, ev) }
+func (s *subject) Rmo(*obs.Sub[RetryEvent])                               {}
+func (s *subject) Err(error)                                              {}
+func (s *subject) Cpt()                                                   {}
+func (s *subject) Uns()                                                   {}
+func (s *subject) Cld() bool                                              { return s.cld }
+
+func TestOf_Hooks(t *testing.T) {
+	config := defaultConfig[any](time.Millisecond, 3, 0)
+	config.Op = "fetch"
+	var retries, successes []RetryEvent
+	config.OnRetry = func(ev RetryEvent) {
+		retries = append(retries, ev)
+	}
+	config.OnSuccess = func(ev RetryEvent) {
+		successes = append(successes, ev)
+	}
+	config.OnGiveUp = func(ev RetryEvent) {
+		t.Errorf("unexpected give up: %v", ev)
+	}
+	attempts := 0
+
+	_, err := Of(config, func() (int, error) {
+		attempts++
+		if attempts < 3 {
+			return 0, errors.New("unavailable")
+		}
+		return 42, nil
+	})
+
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(retries) != 2 || retries[1].Attempt != 2 || retries[1].Err == nil || retries[1].Delay != time.Millisecond {
+		t.Errorf("expected 2 retries, got %v", retries)
+	}
+	if len(successes) != 1 || successes[0].Op != "fetch" || successes[0].Attempt != 3 || successes[0].Elapsed <= 0 {
+		t.Errorf("expected a success after 3 attempts, got %v", successes)
+	}
+}
+
+func TestEach_Publisher(t *testing.T) {
+	config := defaultConfig[int](time.Millisecond, 2, 0)
+	config.Prm = []int{1, 2}
+	pub := &subject{}
+	config.Pub = pub
+
+	_, err := Each(config, func(int) (int, error) {
+		return 0, errors.New("unavailable")
+	})
+
+	var attErr *AttemptsError
+	if !errors.As(err, &attErr) {
+		t.Errorf("expected an AttemptsError, got %v", err)
+	}
+	if len(pub.evs) != 2 || pub.evs[0].Kind != Retried || pub.evs[1].Kind != GaveUp || pub.evs[1].Err != err {
+		t.Errorf("expected the events [retried gave up], got %v", pub.evs)
+	}
+
+	pub.cld = true
+	Each(config, func(int) (int, error) {
+		return 1, nil
+	})
+	if len(pub.evs) != 2 {
+		t.Errorf("expected no event published to a closed subject, got %v", pub.evs)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	mtr := NewMetrics()
+	config := defaultConfig[any](time.Millisecond, 2, 0)
+	config.Mtr = mtr
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(fail bool) {
+			defer wg.Done()
+			cfg := config
+			cfg.Op = "read"
+			if fail {
+				cfg.Op = "write"
+			}
+			Of(cfg, func() (int, error) {
+				if fail {
+					return 0, errors.New("read-only")
+				}
+				return 1, nil
+			})
+		}(i%2 == 0)
+	}
+	wg.Wait()
+
+	want := map[string]Counts{
+		"read":  {Attempts: 5, Successes: 5},
+		"write": {Attempts: 10, Retries: 5, Failures: 5},
+	}
+	if snap := mtr.Snapshot(); !reflect.DeepEqual(snap, want) {
+		t.Errorf("expected %v, got %v", want, snap)
+	}
+	if c := mtr.Get("read"); c.Successes != 5 {
+		t.Errorf("expected 5 successes, got %v", c)
+	}
+	mtr.Reset()
+	if c := mtr.Get("write"); c != (Counts{}) {
+		t.Errorf("expected no counts after Reset(), got %v", c)
+	}
+}
@@ -2,15 +2,44 @@ package retry
 
 import (
 	"context"
+	"github.com/andrerrcosta2/gtools/pkg/gtools"
+	"github.com/andrerrcosta2/gtools/pkg/obs"
 	"time"
 )
 
 type Config[T any] struct {
+	// Op names the operation in the events and the metrics
+	Op string
+	// Ctx stops the attempts once it's done
 	Ctx context.Context
+	// Att is the maximum number of attempts
 	Att uint
+	// Tmo is the timeout of every attempt. Zero means no timeout
 	Tmo time.Duration
-	Dl  time.Duration
-	Bf  float64
+	// Dl is the delay before the first attempt
+	Dl time.Duration
+	// Bf multiplies the delay after every attempt, unless Bo is set
+	Bf float64
+	// Bo computes the delay before every attempt but the first one
+	Bo Backoff
+	// Rtr tells if an error can be retried. Nil means every error but the permanent ones
+	Rtr func(error) bool
+	// Sev is the severity from which a gtools.LeveledError isn't retried. Zero means any severity is retried
+	Sev gtools.ErrorSeverity
+	// Prm are the parameters of the attempts of Each, one for each attempt
 	Prm []T
+	// Srt sorts Prm before the attempts
 	Srt func(T, T) bool
+	// Log is called after every failed attempt
+	Log func(Attempt)
+	// OnRetry is called after every failed attempt followed by another one
+	OnRetry func(RetryEvent)
+	// OnGiveUp is called once the attempts stop without a success
+	OnGiveUp func(RetryEvent)
+	// OnSuccess is called once an attempt succeeds
+	OnSuccess func(RetryEvent)
+	// Pub publishes every event, unless it's closed
+	Pub obs.Sbj[RetryEvent]
+	// Mtr counts the attempts and their outcomes under Op
+	Mtr *Metrics
 }
@@ -1,65 +1,152 @@
 package retry
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"sort"
-	"strings"
 	"time"
 )
 
 func Of[K any](opt Config[any], fn func() (K, error)) (K, error) {
-	var err error
-	var res K
-	var dl = opt.Dl
-	var attErr []string
+	return OfCtx(opt, func(context.Context) (K, error) {
+		return fn()
+	})
+}
+
+// OfCtx works like Of, but fn receives the context of the attempt, which is done
+// once Ctx is done or the attempt times out.
+func OfCtx[K any](opt Config[any], fn func(context.Context) (K, error)) (K, error) {
 	if opt.Att == 0 {
 		opt.Att = 1
 	}
 
-	for i := 0; i < int(opt.Att); i++ {
-		select {
-		case <-time.After(dl):
-			res, err = fn()
-			if err == nil {
-				return res, nil
-			}
-			attErr = append(attErr, fmt.Sprintf("attempt %d failed: %s", i, err))
+	return loop(&opt, func(ctx context.Context, _ int) (K, error) {
+		return fn(ctx)
+	})
+}
 
-			if opt.Bf > 0.0 {
-				dl = time.Duration(float64(dl) * opt.Bf)
-			}
+func Each[T any, K any](opt Config[T], fn func(T) (K, error)) (K, error) {
+	return EachCtx(opt, func(_ context.Context, prm T) (K, error) {
+		return fn(prm)
+	})
+}
 
-		case <-opt.Ctx.Done():
-			return res, opt.Ctx.Err()
-		}
-	}
-	return res, fmt.Errorf("sm failed: all %d attempts failed\n\n%s", opt.Att, strings.Join(attErr, "\n"))
+// EachCtx works like Each, but fn receives the context of the attempt, which is done
+// once Ctx is done or the attempt times out.
+func EachCtx[T any, K any](opt Config[T], fn func(context.Context, T) (K, error)) (K, error) {
+	var prms = bprm(&opt)
+
+	return loop(&opt, func(ctx context.Context, i int) (K, error) {
+		return fn(ctx, prms[i])
+	})
 }
 
-func Each[T any, K any](opt Config[T], fn func(T) (K, error)) (K, error) {
-	var err error
+// loop makes the attempts of Of and Each, notifying the events of the configuration.
+//
+// Returns:
+// - K: The result of the last attempt.
+// - error: Nil if an attempt succeeded, an AttemptsError if every attempt failed, the error of
+// an attempt which can't be retried, or the context error.
+func loop[T any, K any](opt *Config[T], fn func(ctx context.Context, i int) (K, error)) (K, error) {
 	var res K
 	var dl = opt.Dl
-	var prms = bprm(&opt)
+	var failed []Attempt
+	var start = time.Now()
+	if opt.Ctx == nil {
+		opt.Ctx = context.Background()
+	}
+
+	giveUp := func(made int, err error) (K, error) {
+		notify(opt, RetryEvent{Kind: GaveUp, Attempt: made, Err: err, Elapsed: time.Since(start)})
+		return res, err
+	}
 
 	for i := 0; i < int(opt.Att); i++ {
 		select {
 		case <-time.After(dl):
-			res, err = fn(prms[i])
+			var err error
+			began := time.Now()
+			res, err = run(opt, i, fn)
+			if opt.Mtr != nil {
+				opt.Mtr.attempt(opt.Op)
+			}
 			if err == nil {
+				notify(opt, RetryEvent{Kind: Succeeded, Attempt: i + 1, Elapsed: time.Since(start)})
 				return res, nil
 			}
-			fmt.Printf("attempt %d failed: %s\n", i, err)
+			if opt.Ctx.Err() != nil {
+				return giveUp(i+1, opt.Ctx.Err())
+			}
 
-			if opt.Bf > 0.0 {
-				dl = time.Duration(float64(dl) * opt.Bf)
+			a := Attempt{Index: i, Err: err, Dur: time.Since(began)}
+			if i < len(opt.Prm) {
+				a.Prm = opt.Prm[i]
+			}
+			failed = append(failed, a)
+			if opt.Log != nil {
+				opt.Log(a)
+			}
+
+			if !retryable(opt, err) {
+				return giveUp(i+1, cause(err))
+			}
+			if i+1 < int(opt.Att) {
+				// Wait the longest of the backoff and the hint of the error
+				dl = max(delay(opt, i+1, dl), RetryAfter(err))
+				notify(opt, RetryEvent{Kind: Retried, Attempt: i + 1, Delay: dl, Err: err, Elapsed: time.Since(start)})
 			}
 
 		case <-opt.Ctx.Done():
-			return res, opt.Ctx.Err()
+			return giveUp(i, opt.Ctx.Err())
 		}
 	}
-	return res, fmt.Errorf("all %d attempts failed\n", opt.Att)
+	return giveUp(len(failed), &AttemptsError{Attempts: failed})
+}
+
+// run makes an attempt, giving up on it once it times out. An attempt that doesn't
+// stop with its context keeps running in its own goroutine, but its result is dropped.
+func run[T any, K any](opt *Config[T], i int, fn func(ctx context.Context, i int) (K, error)) (K, error) {
+	if opt.Tmo <= 0 {
+		return fn(opt.Ctx, i)
+	}
+
+	ctx, cancel := context.WithTimeout(opt.Ctx, opt.Tmo)
+	defer cancel()
+
+	type result struct {
+		val K
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		val, err := fn(ctx, i)
+		done <- result{val, err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.val, r.err
+	case <-ctx.Done():
+		var zero K
+		// Only the deadline of the attempt is a timeout, a done parent context stops every attempt
+		if opt.Ctx.Err() != nil || !errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return zero, ctx.Err()
+		}
+		return zero, fmt.Errorf("attempt timed out after %v: %w", opt.Tmo, ctx.Err())
+	}
+}
+
+// delay returns the delay before the next attempt, computed by the Backoff if there's one,
+// or by the legacy Bf factor otherwise.
+func delay[T any](opt *Config[T], attempt int, last time.Duration) time.Duration {
+	if opt.Bo != nil {
+		return opt.Bo.Delay(attempt, last)
+	}
+	if opt.Bf > 0.0 {
+		return time.Duration(float64(last) * opt.Bf)
+	}
+	return last
 }
 
 func bprm[T any](opt *Config[T]) []T {
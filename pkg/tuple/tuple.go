@@ -2,7 +2,10 @@
 
 package tuple
 
-import "github.com/andrerrcosta2/gtools/pkg/generics"
+import (
+	"github.com/andrerrcosta2/gtools/pkg/generics"
+	"iter"
+)
 
 func NewPair[A any, B any](a A, b B) *Pair[A, B] {
 	return &Pair[A, B]{
@@ -24,6 +27,33 @@ func (p *Pair[A, B]) Second() B {
 	return p.second
 }
 
+// All returns a sequence yielding the pair once, so it can be ranged over like a map with a single entry.
+func (p *Pair[A, B]) All() iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		yield(p.first, p.second)
+	}
+}
+
+// All returns a sequence of the elements of the pairs.
+func All[A any, B any](pairs ...*Pair[A, B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		for _, p := range pairs {
+			if !yield(p.first, p.second) {
+				return
+			}
+		}
+	}
+}
+
+// Collect gathers the elements of a sequence into pairs, keeping their order.
+func Collect[A any, B any](seq iter.Seq2[A, B]) []*Pair[A, B] {
+	var pairs []*Pair[A, B]
+	for a, b := range seq {
+		pairs = append(pairs, NewPair(a, b))
+	}
+	return pairs
+}
+
 func DerefPair[A any, B any](b generics.BiTypedInterface[A, B]) (A, B) {
 	var aZero A
 	var bZero B
@@ -3,6 +3,7 @@
 package typers
 
 import (
+	"github.com/andrerrcosta2/gtools/pkg/either"
 	"strings"
 )
 
@@ -73,6 +74,19 @@ func Or[G any, K any](a any) any {
 	return nil
 }
 
+// OrEither is the typed counterpart of Or: it returns the value as an Either holding
+// a value of type G if it's one, or a value of type K if it's one.
+// The boolean is false if the value is of neither type.
+func OrEither[G any, K any](a any) (either.Either[G, K], bool) {
+	if val, ok := a.(G); ok {
+		return either.Left[G, K](val), true
+	}
+	if val, ok := a.(K); ok {
+		return either.Right[G](val), true
+	}
+	return either.Either[G, K]{}, false
+}
+
 func Ors[G any, K any](a ...any) ([]G, []K) {
 	gs := make([]G, 0, len(a))
 	ks := make([]K, 0, len(a))
@@ -77,3 +77,19 @@ func TestXrtSymbol(t *testing.T) {
 		}
 	}
 }
+
+func TestOrEither(t *testing.T) {
+	e, ok := OrEither[int, string]("text")
+	if s, isRight := e.Right(); !ok || !isRight || s != "text" {
+		t.Errorf("OrEither(text) = %v, %v, want Right(text)", e, ok)
+	}
+
+	e, ok = OrEither[int, string](3)
+	if v, isLeft := e.Left(); !ok || !isLeft || v != 3 {
+		t.Errorf("OrEither(3) = %v, %v, want Left(3)", e, ok)
+	}
+
+	if _, ok = OrEither[int, string](3.5); ok {
+		t.Errorf("OrEither(3.5) returned true for a value of neither type")
+	}
+}